@@ -0,0 +1,397 @@
+// Package bigmath implements the arbitrary-precision arithmetic of the
+// Calculator service on operands written as decimal strings.
+package bigmath
+
+import (
+	"fmt"
+	"math/big"
+	"regexp"
+	"strconv"
+)
+
+// Kind selects the type the operands are parsed into.
+type Kind int
+
+const (
+	// Integer operands are big.Ints. Division truncates towards zero and
+	// returns the remainder, roots are truncated towards zero.
+	Integer Kind = iota
+	// Rational operands are exact big.Rats written as decimals, e.g. "0.1",
+	// or fractions, e.g. "1/3".
+	Rational
+	// Float operands are big.Floats with Options.Prec bits of mantissa,
+	// rounded with Options.Mode.
+	Float
+)
+
+// Op is an arithmetic operation on two operands.
+type Op int
+
+// Operations supported by Compute. The second operand of Pow is the
+// exponent and the one of Root the degree, both integers.
+const (
+	Add Op = iota
+	Sub
+	Mul
+	Div
+	Pow
+	Root
+)
+
+var opNames = [...]string{"add", "subtract", "multiply", "divide", "power", "root"}
+
+func (op Op) String() string {
+	if op < 0 || int(op) >= len(opNames) {
+		return "op(" + strconv.Itoa(int(op)) + ")"
+	}
+	return opNames[op]
+}
+
+const (
+	// DefaultPrec is the precision of Float operations when none is given,
+	// about 77 decimal digits.
+	DefaultPrec = 256
+	// MaxPrec is the largest precision of Float operations.
+	MaxPrec = 1 << 16
+	// MaxDegree is the largest degree of a root.
+	MaxDegree = 1 << 10
+	// maxBits bounds the size of Integer and Rational powers.
+	maxBits = 1 << 20
+	// maxExp bounds the decimal exponent of Rational operands, e.g. 1e-4096,
+	// which are expanded into integers.
+	maxExp = 1 << 12
+)
+
+// Options configures an operation.
+type Options struct {
+	Kind Kind
+	// Prec is the number of mantissa bits of Float operands and results, 0
+	// meaning DefaultPrec.
+	Prec uint
+	// Mode is the rounding mode of Float results.
+	Mode big.RoundingMode
+}
+
+// Result is the outcome of an operation.
+type Result struct {
+	// Value is the result in decimal, as a fraction for Rational results
+	// that are not integers.
+	Value string
+	// Remainder is the remainder of Integer divisions.
+	Remainder string
+	// Exact reports whether Value is the exact result. Float results are
+	// only reported exact when the operands were and no rounding happened.
+	Exact bool
+}
+
+// Error is returned for operands an operation cannot be applied to.
+type Error struct {
+	// Field is the invalid input, "x", "y", "kind" or "precision", or empty
+	// when the operation as a whole fails.
+	Field string
+	// TooLarge is set when the result exceeds the size limits rather than
+	// being undefined.
+	TooLarge bool
+	Msg      string
+}
+
+func (e *Error) Error() string {
+	return e.Msg
+}
+
+func invalidf(field, format string, args ...interface{}) error {
+	return &Error{Field: field, Msg: fmt.Sprintf(format, args...)}
+}
+
+func tooLargef(field, format string, args ...interface{}) error {
+	return &Error{Field: field, TooLarge: true, Msg: fmt.Sprintf(format, args...)}
+}
+
+var (
+	intRe  = regexp.MustCompile(`^[+-]?[0-9]+$`)
+	fracRe = regexp.MustCompile(`^[+-]?[0-9]+/[0-9]+$`)
+	decRe  = regexp.MustCompile(`^[+-]?([0-9]+\.?[0-9]*|\.[0-9]+)([eE]([+-]?[0-9]+))?$`)
+)
+
+// Compute applies op to x and y.
+func Compute(op Op, x, y string, opts Options) (Result, error) {
+	if op < Add || op > Root {
+		return Result{}, invalidf("", "unknown operation %v", op)
+	}
+	switch opts.Kind {
+	case Integer:
+		return computeInt(op, x, y)
+	case Rational:
+		return computeRat(op, x, y)
+	case Float:
+		prec := opts.Prec
+		if prec == 0 {
+			prec = DefaultPrec
+		}
+		if prec > MaxPrec {
+			return Result{}, invalidf("precision", "precision of %d bits exceeds the maximum of %d", prec, MaxPrec)
+		}
+		return computeFloat(op, x, y, prec, opts.Mode)
+	}
+	return Result{}, invalidf("kind", "unknown kind %d", opts.Kind)
+}
+
+func parseInt(name, s string) (*big.Int, error) {
+	if !intRe.MatchString(s) {
+		return nil, invalidf(name, "%s %q is not an integer", name, s)
+	}
+	n, _ := new(big.Int).SetString(s, 10)
+	return n, nil
+}
+
+func parseRat(name, s string) (*big.Rat, error) {
+	switch {
+	case fracRe.MatchString(s):
+	case decRe.MatchString(s):
+		if exp := decRe.FindStringSubmatch(s)[3]; exp != "" {
+			if e, err := strconv.Atoi(exp); err != nil || e > maxExp || e < -maxExp {
+				return nil, tooLargef(name, "exponent of %s is out of the range [-%d, %d]", name, maxExp, maxExp)
+			}
+		}
+	default:
+		return nil, invalidf(name, "%s %q is not a decimal or a fraction", name, s)
+	}
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return nil, invalidf(name, "%s %q has a zero denominator", name, s)
+	}
+	return r, nil
+}
+
+func parseFloat(name, s string, prec uint, mode big.RoundingMode) (*big.Float, error) {
+	if !decRe.MatchString(s) {
+		return nil, invalidf(name, "%s %q is not a decimal", name, s)
+	}
+	f := new(big.Float).SetPrec(prec).SetMode(mode)
+	if _, _, err := f.Parse(s, 10); err != nil {
+		return nil, tooLargef(name, "%s %q is out of range: %v", name, s, err)
+	}
+	if f.IsInf() {
+		return nil, tooLargef(name, "%s %q is out of range", name, s)
+	}
+	return f, nil
+}
+
+// parseDegree parses the degree of a root.
+func parseDegree(s string) (uint, error) {
+	k, err := parseInt("y", s)
+	if err != nil {
+		return 0, err
+	}
+	if k.Sign() <= 0 || k.Cmp(big.NewInt(MaxDegree)) > 0 {
+		return 0, invalidf("y", "degree %v is not between 1 and %d", k, MaxDegree)
+	}
+	return uint(k.Uint64()), nil
+}
+
+// checkPowSize fails when raising a number of bits bits to the power e would
+// exceed maxBits.
+func checkPowSize(bits int, e *big.Int) error {
+	if bits <= 1 {
+		return nil
+	}
+	if abs := new(big.Int).Abs(e); !abs.IsInt64() || abs.Int64() > int64(maxBits/bits) {
+		return tooLargef("y", "the power would exceed %d bits", maxBits)
+	}
+	return nil
+}
+
+func divisionByZero() error {
+	return invalidf("y", "division by zero")
+}
+
+func computeInt(op Op, x, y string) (Result, error) {
+	a, err := parseInt("x", x)
+	if err != nil {
+		return Result{}, err
+	}
+	if op == Root {
+		k, err := parseDegree(y)
+		if err != nil {
+			return Result{}, err
+		}
+		if a.Sign() < 0 && k%2 == 0 {
+			return Result{}, invalidf("x", "even root of negative number %v", a)
+		}
+		z := intRoot(new(big.Int).Abs(a), k)
+		exact := new(big.Int).Exp(z, big.NewInt(int64(k)), nil).CmpAbs(a) == 0
+		if a.Sign() < 0 {
+			z.Neg(z)
+		}
+		return Result{Value: z.String(), Exact: exact}, nil
+	}
+	b, err := parseInt("y", y)
+	if err != nil {
+		return Result{}, err
+	}
+
+	z := new(big.Int)
+	switch op {
+	case Add:
+		z.Add(a, b)
+	case Sub:
+		z.Sub(a, b)
+	case Mul:
+		z.Mul(a, b)
+	case Div:
+		if b.Sign() == 0 {
+			return Result{}, divisionByZero()
+		}
+		r := new(big.Int)
+		z.QuoRem(a, b, r)
+		return Result{Value: z.String(), Remainder: r.String(), Exact: r.Sign() == 0}, nil
+	case Pow:
+		if b.Sign() < 0 {
+			return Result{}, invalidf("y", "negative exponent %v requires a RATIONAL or FLOAT operation", b)
+		}
+		if err := checkPowSize(a.BitLen(), b); err != nil {
+			return Result{}, err
+		}
+		z.Exp(a, b, nil)
+	}
+	return Result{Value: z.String(), Exact: true}, nil
+}
+
+func computeRat(op Op, x, y string) (Result, error) {
+	a, err := parseRat("x", x)
+	if err != nil {
+		return Result{}, err
+	}
+	z := new(big.Rat)
+	switch op {
+	case Pow:
+		e, err := parseInt("y", y)
+		if err != nil {
+			return Result{}, err
+		}
+		bits := a.Num().BitLen()
+		if d := a.Denom().BitLen(); d > bits {
+			bits = d
+		}
+		if err := checkPowSize(bits, e); err != nil {
+			return Result{}, err
+		}
+		if e.Sign() < 0 {
+			if a.Sign() == 0 {
+				return Result{}, divisionByZero()
+			}
+			a = new(big.Rat).Inv(a)
+			e = new(big.Int).Neg(e)
+		}
+		num := new(big.Int).Exp(a.Num(), e, nil)
+		den := new(big.Int).Exp(a.Denom(), e, nil)
+		z.SetFrac(num, den)
+	case Root:
+		k, err := parseDegree(y)
+		if err != nil {
+			return Result{}, err
+		}
+		if a.Sign() < 0 && k%2 == 0 {
+			return Result{}, invalidf("x", "even root of negative number %v", a.RatString())
+		}
+		num, numExact := exactRoot(new(big.Int).Abs(a.Num()), k)
+		den, denExact := exactRoot(a.Denom(), k)
+		if !numExact || !denExact {
+			return Result{}, invalidf("x", "root of %v is not rational, use a FLOAT operation", a.RatString())
+		}
+		z.SetFrac(num, den)
+		if a.Sign() < 0 {
+			z.Neg(z)
+		}
+	default:
+		b, err := parseRat("y", y)
+		if err != nil {
+			return Result{}, err
+		}
+		switch op {
+		case Add:
+			z.Add(a, b)
+		case Sub:
+			z.Sub(a, b)
+		case Mul:
+			z.Mul(a, b)
+		case Div:
+			if b.Sign() == 0 {
+				return Result{}, divisionByZero()
+			}
+			z.Quo(a, b)
+		}
+	}
+	return Result{Value: z.RatString(), Exact: true}, nil
+}
+
+func computeFloat(op Op, x, y string, prec uint, mode big.RoundingMode) (Result, error) {
+	a, err := parseFloat("x", x, prec, mode)
+	if err != nil {
+		return Result{}, err
+	}
+	exact := a.Acc() == big.Exact
+	z := new(big.Float).SetPrec(prec).SetMode(mode)
+	switch op {
+	case Pow:
+		e, err := parseInt("y", y)
+		if err != nil {
+			return Result{}, err
+		}
+		if !e.IsInt64() {
+			return Result{}, tooLargef("y", "exponent %v is out of range", e)
+		}
+		n := e.Int64()
+		if n < 0 {
+			if a.Sign() == 0 {
+				return Result{}, divisionByZero()
+			}
+			n = -n
+		}
+		wprec := prec + 64
+		w, wexact := powFloat(a, uint64(n), wprec)
+		if e.Sign() < 0 {
+			w = new(big.Float).SetPrec(wprec).Quo(new(big.Float).SetPrec(wprec).SetInt64(1), w)
+			wexact = wexact && w.Acc() == big.Exact
+		}
+		z.Set(w)
+		exact = exact && wexact
+	case Root:
+		k, err := parseDegree(y)
+		if err != nil {
+			return Result{}, err
+		}
+		if a.Sign() < 0 && k%2 == 0 {
+			return Result{}, invalidf("x", "even root of negative number %v", x)
+		}
+		abs := new(big.Float).Abs(a)
+		z.Set(floatRoot(abs, k, prec+64))
+		if a.Sign() < 0 {
+			z.Neg(z)
+		}
+		exact = exact && isRootOf(new(big.Float).Abs(z), k, abs)
+	default:
+		b, err := parseFloat("y", y, prec, mode)
+		if err != nil {
+			return Result{}, err
+		}
+		exact = exact && b.Acc() == big.Exact
+		switch op {
+		case Add:
+			z.Add(a, b)
+		case Sub:
+			z.Sub(a, b)
+		case Mul:
+			z.Mul(a, b)
+		case Div:
+			if b.Sign() == 0 {
+				return Result{}, divisionByZero()
+			}
+			z.Quo(a, b)
+		}
+	}
+	if z.IsInf() {
+		return Result{}, tooLargef("", "the result of %v is out of range", op)
+	}
+	return Result{Value: z.Text('g', -1), Exact: exact && z.Acc() == big.Exact}, nil
+}
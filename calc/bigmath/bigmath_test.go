@@ -0,0 +1,111 @@
+package bigmath
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestCompute(t *testing.T) {
+	integer := Options{Kind: Integer}
+	rational := Options{Kind: Rational}
+	float64Prec := Options{Kind: Float, Prec: 53}
+	tests := []struct {
+		op   Op
+		x, y string
+		opts Options
+		want Result
+	}{
+		{Add, "9223372036854775807", "1", integer, Result{Value: "9223372036854775808", Exact: true}},
+		{Sub, "-18446744073709551616", "1", integer, Result{Value: "-18446744073709551617", Exact: true}},
+		{Mul, "4294967296", "-4294967296", integer, Result{Value: "-18446744073709551616", Exact: true}},
+		{Div, "-7", "2", integer, Result{Value: "-3", Remainder: "-1"}},
+		{Div, "12", "-4", integer, Result{Value: "-3", Remainder: "0", Exact: true}},
+		{Pow, "2", "100", integer, Result{Value: "1267650600228229401496703205376", Exact: true}},
+		{Pow, "0", "0", integer, Result{Value: "1", Exact: true}},
+		{Pow, "-1", "100000000000000000001", integer, Result{Value: "-1", Exact: true}},
+		{Root, "1000", "3", integer, Result{Value: "10", Exact: true}},
+		{Root, "-1000", "3", integer, Result{Value: "-10", Exact: true}},
+		{Root, "99", "2", integer, Result{Value: "9"}},
+		{Root, "-999", "3", integer, Result{Value: "-9"}},
+		{Root, "18446744073709551615", "64", integer, Result{Value: "1"}},
+		{Root, "18446744073709551616", "64", integer, Result{Value: "2", Exact: true}},
+
+		{Add, "0.1", "0.2", rational, Result{Value: "3/10", Exact: true}},
+		{Div, "1", "3", rational, Result{Value: "1/3", Exact: true}},
+		{Mul, "2/3", "1.5", rational, Result{Value: "1", Exact: true}},
+		{Sub, "1e-3", ".001", rational, Result{Value: "0", Exact: true}},
+		{Pow, "2/3", "-2", rational, Result{Value: "9/4", Exact: true}},
+		{Root, "4/9", "2", rational, Result{Value: "2/3", Exact: true}},
+		{Root, "-0.125", "3", rational, Result{Value: "-1/2", Exact: true}},
+
+		{Add, "0.5", "0.25", float64Prec, Result{Value: "0.75", Exact: true}},
+		{Add, "0.1", "0.2", float64Prec, Result{Value: "0.30000000000000004"}},
+		{Div, "1", "3", Options{Kind: Float, Prec: 64}, Result{Value: "0.33333333333333333334"}},
+		{Div, "1", "3", Options{Kind: Float, Prec: 53, Mode: big.ToZero}, Result{Value: "0.3333333333333333"}},
+		{Pow, "2", "-2", float64Prec, Result{Value: "0.25", Exact: true}},
+		{Pow, "10", "-1", float64Prec, Result{Value: "0.1"}},
+		{Root, "2", "2", float64Prec, Result{Value: "1.4142135623730951"}},
+		{Root, "2", "3", float64Prec, Result{Value: "1.2599210498948732"}},
+		{Root, "27", "3", float64Prec, Result{Value: "3", Exact: true}},
+		{Root, "-0.125", "3", float64Prec, Result{Value: "-0.5", Exact: true}},
+		{Root, "1e-300", "1024", float64Prec, Result{Value: "0.5093675216780135"}},
+	}
+	for _, tt := range tests {
+		got, err := Compute(tt.op, tt.x, tt.y, tt.opts)
+		if err != nil || got != tt.want {
+			t.Errorf("Compute(%v, %q, %q, %+v) = %+v, %v, want %+v", tt.op, tt.x, tt.y, tt.opts, got, err, tt.want)
+		}
+	}
+}
+
+func TestComputeErrors(t *testing.T) {
+	integer := Options{Kind: Integer}
+	rational := Options{Kind: Rational}
+	float := Options{Kind: Float}
+	tests := []struct {
+		op       Op
+		x, y     string
+		opts     Options
+		field    string
+		tooLarge bool
+	}{
+		{Root + 1, "1", "1", integer, "", false},
+		{Add, "1", "1", Options{Kind: Float + 1}, "kind", false},
+		{Add, "1", "1", Options{Kind: Float, Prec: MaxPrec + 1}, "precision", false},
+
+		{Add, "1.5", "1", integer, "x", false},
+		{Add, "1", "", integer, "y", false},
+		{Div, "1", "0", integer, "y", false},
+		{Pow, "2", "-1", integer, "y", false},
+		{Pow, "3", "524289", integer, "y", true},
+		{Pow, "2", "9223372036854775808", integer, "y", true},
+		{Root, "-4", "2", integer, "x", false},
+		{Root, "8", "0", integer, "y", false},
+		{Root, "8", "1025", integer, "y", false},
+
+		{Add, "1/0", "1", rational, "x", false},
+		{Add, "1", "0x10", rational, "y", false},
+		{Add, "1e4097", "1", rational, "x", true},
+		{Div, "1", "0/5", rational, "y", false},
+		{Pow, "1.5", "0.5", rational, "y", false},
+		{Pow, "0", "-1", rational, "y", false},
+		{Root, "2", "2", rational, "x", false},
+		{Root, "-4", "2", rational, "x", false},
+
+		{Add, "1/3", "1", float, "x", false},
+		{Add, "1e1000000000", "1", float, "x", true},
+		{Div, "1", "0", float, "y", false},
+		{Pow, "1.5", "x", float, "y", false},
+		{Pow, "2", "9223372036854775808", float, "y", true},
+		{Pow, "0", "-1", float, "y", false},
+		{Pow, "10", "9999999999", float, "", true},
+		{Root, "-4", "2", float, "x", false},
+	}
+	for _, tt := range tests {
+		_, err := Compute(tt.op, tt.x, tt.y, tt.opts)
+		e, ok := err.(*Error)
+		if !ok || e.Field != tt.field || e.TooLarge != tt.tooLarge {
+			t.Errorf("Compute(%v, %q, %q, %+v) = %#v, want an error of field %q, too large %v", tt.op, tt.x, tt.y, tt.opts, err, tt.field, tt.tooLarge)
+		}
+	}
+}
@@ -0,0 +1,110 @@
+package bigmath
+
+import (
+	"math"
+	"math/big"
+)
+
+// intRoot returns the k-th root of n >= 0 truncated to an integer.
+func intRoot(n *big.Int, k uint) *big.Int {
+	if k == 1 || n.Sign() == 0 {
+		return new(big.Int).Set(n)
+	}
+	if k == 2 {
+		return new(big.Int).Sqrt(n)
+	}
+	// Newton's method from above converges to the truncated root.
+	bk := big.NewInt(int64(k))
+	bk1 := big.NewInt(int64(k - 1))
+	x := new(big.Int).Lsh(big.NewInt(1), (uint(n.BitLen())+k-1)/k)
+	for {
+		// y = ((k-1)*x + n/x^(k-1)) / k
+		t := new(big.Int).Exp(x, bk1, nil)
+		t.Quo(n, t)
+		y := new(big.Int).Mul(x, bk1)
+		y.Add(y, t).Quo(y, bk)
+		if y.Cmp(x) >= 0 {
+			return x
+		}
+		x = y
+	}
+}
+
+// exactRoot returns the k-th root of n >= 0 and whether it is exact.
+func exactRoot(n *big.Int, k uint) (*big.Int, bool) {
+	r := intRoot(n, k)
+	return r, new(big.Int).Exp(r, big.NewInt(int64(k)), nil).Cmp(n) == 0
+}
+
+// powFloat returns x**n computed with prec bits and whether no rounding
+// happened.
+func powFloat(x *big.Float, n uint64, prec uint) (*big.Float, bool) {
+	z := new(big.Float).SetPrec(prec).SetInt64(1)
+	b := new(big.Float).SetPrec(prec).Set(x)
+	exact := b.Acc() == big.Exact
+	for n > 0 {
+		if n&1 == 1 {
+			z.Mul(z, b)
+			exact = exact && z.Acc() == big.Exact
+		}
+		n >>= 1
+		if n > 0 {
+			b.Mul(b, b)
+			exact = exact && b.Acc() == big.Exact
+		}
+	}
+	return z, exact
+}
+
+// floatRoot returns the k-th root of x >= 0 with prec bits, using Newton's
+// method from a float64 estimate.
+func floatRoot(x *big.Float, k uint, prec uint) *big.Float {
+	if x.Sign() == 0 || k == 1 {
+		return new(big.Float).SetPrec(prec).Set(x)
+	}
+	if k == 2 {
+		return new(big.Float).SetPrec(prec).Sqrt(x)
+	}
+
+	// x = mant * 2**exp with mant in [0.5, 1), so the root is
+	// (mant * 2**r)**(1/k) * 2**q with exp = q*k + r and r in [0, k).
+	mant := new(big.Float)
+	exp := x.MantExp(mant)
+	q, r := exp/int(k), exp%int(k)
+	if r < 0 {
+		q, r = q-1, r+int(k)
+	}
+	m, _ := mant.Float64()
+	guess := math.Pow(m*math.Pow(2, float64(r)), 1/float64(k))
+	z := new(big.Float).SetPrec(prec).SetMantExp(big.NewFloat(guess), q)
+
+	fk := new(big.Float).SetPrec(prec).SetInt64(int64(k))
+	fk1 := new(big.Float).SetPrec(prec).SetInt64(int64(k - 1))
+	for i := 0; i < 64; i++ {
+		// y = ((k-1)*z + x/z^(k-1)) / k
+		t, _ := powFloat(z, uint64(k-1), prec)
+		t.Quo(x, t)
+		y := new(big.Float).SetPrec(prec).Mul(z, fk1)
+		y.Add(y, t).Quo(y, fk)
+		d := new(big.Float).Sub(y, z)
+		z = y
+		if d.Sign() == 0 || d.MantExp(nil) < z.MantExp(nil)-int(prec)+2 {
+			break
+		}
+	}
+	return z
+}
+
+// isRootOf reports whether r**k == x exactly. It gives up, returning false,
+// when the check would need more than maxBits bits.
+func isRootOf(r *big.Float, k uint, x *big.Float) bool {
+	prec := r.MinPrec() * k
+	if prec > maxBits {
+		return false
+	}
+	if prec == 0 {
+		return x.Sign() == 0
+	}
+	p, exact := powFloat(r, uint64(k), prec)
+	return exact && p.Cmp(x) == 0
+}
@@ -0,0 +1,141 @@
+package bigmath
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestIntRoot(t *testing.T) {
+	one := big.NewInt(1)
+	bases := []*big.Int{
+		big.NewInt(1),
+		big.NewInt(2),
+		big.NewInt(3),
+		big.NewInt(1000),
+		new(big.Int).SetUint64(1<<64 - 1),
+		new(big.Int).Exp(big.NewInt(10), big.NewInt(40), nil),
+	}
+	for k := uint(1); k <= 12; k++ {
+		bk := big.NewInt(int64(k))
+		for _, r := range bases {
+			// Around the perfect power r^k the root is r-1, then r.
+			n := new(big.Int).Exp(r, bk, nil)
+			tests := []struct {
+				n, want *big.Int
+			}{
+				{new(big.Int).Sub(n, one), new(big.Int).Sub(r, one)},
+				{n, r},
+				{new(big.Int).Add(n, one), r},
+			}
+			for _, tt := range tests {
+				if k == 1 {
+					tt.want = tt.n
+				}
+				if got := intRoot(tt.n, k); got.Cmp(tt.want) != 0 {
+					t.Errorf("intRoot(%v, %d) = %v, want %v", tt.n, k, got, tt.want)
+				}
+			}
+		}
+	}
+	if got := intRoot(new(big.Int), 5); got.Sign() != 0 {
+		t.Errorf("intRoot(0, 5) = %v, want 0", got)
+	}
+}
+
+func TestExactRoot(t *testing.T) {
+	tests := []struct {
+		n     int64
+		k     uint
+		want  int64
+		exact bool
+	}{
+		{0, 3, 0, true},
+		{1, 7, 1, true},
+		{1024, 10, 2, true},
+		{1023, 10, 1, false},
+		{1025, 10, 2, false},
+		{9223372030926249001, 2, 3037000499, true},
+		{9223372036854775807, 2, 3037000499, false},
+	}
+	for _, tt := range tests {
+		got, exact := exactRoot(big.NewInt(tt.n), tt.k)
+		if got.Int64() != tt.want || exact != tt.exact {
+			t.Errorf("exactRoot(%d, %d) = %v, %v, want %d, %v", tt.n, tt.k, got, exact, tt.want, tt.exact)
+		}
+	}
+}
+
+func TestFloatRoot(t *testing.T) {
+	const prec = 256
+	tests := []struct {
+		x string
+		k uint
+	}{
+		{"2", 2},
+		{"2", 3},
+		{"0.001", 3},
+		{"1e-300", 7},
+		{"1e300", 7},
+		{"123456789.123456789", 5},
+		{"3", 1024},
+		{"1e-100", 1023},
+	}
+	for _, tt := range tests {
+		x, _, err := big.ParseFloat(tt.x, 10, prec, big.ToNearestEven)
+		if err != nil {
+			t.Fatal(err)
+		}
+		z := floatRoot(x, tt.k, prec)
+		// The relative error of z^k is about k times the one of z.
+		p, _ := powFloat(z, uint64(tt.k), 2*prec)
+		rel := new(big.Float).Sub(p, x)
+		rel.Quo(rel.Abs(rel), x)
+		if rel.Sign() != 0 && rel.MantExp(nil) > -prec+12 {
+			t.Errorf("floatRoot(%s, %d)^%d is off by a relative %v", tt.x, tt.k, tt.k, rel)
+		}
+	}
+}
+
+func TestFloatRootExact(t *testing.T) {
+	tests := []struct {
+		x, want string
+		k       uint
+	}{
+		{"0", "0", 3},
+		{"5", "5", 1},
+		{"16", "4", 2},
+		{"27", "3", 3},
+		{"0.125", "0.5", 3},
+		{"1024", "2", 10},
+		{"340282366920938463463374607431768211456", "2", 128},
+	}
+	for _, tt := range tests {
+		x, _, _ := big.ParseFloat(tt.x, 10, 256, big.ToNearestEven)
+		want, _, _ := big.ParseFloat(tt.want, 10, 256, big.ToNearestEven)
+		z := floatRoot(x, tt.k, 256)
+		if got := new(big.Float).SetPrec(192).Set(z); got.Cmp(new(big.Float).SetPrec(192).Set(want)) != 0 {
+			t.Errorf("floatRoot(%s, %d) = %v, want %s", tt.x, tt.k, z, tt.want)
+		}
+	}
+}
+
+func TestIsRootOf(t *testing.T) {
+	tests := []struct {
+		r, x string
+		k    uint
+		want bool
+	}{
+		{"0", "0", 3, true},
+		{"3", "27", 3, true},
+		{"0.5", "0.125", 3, true},
+		{"3", "28", 3, false},
+		{"1.4142135623730951", "2", 2, false},
+	}
+	for _, tt := range tests {
+		r, _, _ := big.ParseFloat(tt.r, 10, 64, big.ToNearestEven)
+		x, _, _ := big.ParseFloat(tt.x, 10, 64, big.ToNearestEven)
+		if got := isRootOf(r, tt.k, x); got != tt.want {
+			t.Errorf("isRootOf(%s, %d, %s) = %v, want %v", tt.r, tt.k, tt.x, got, tt.want)
+		}
+	}
+}
@@ -2,8 +2,12 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	calcpb "grpc-course/calc/calc_proto"
+	"grpc-course/common/auth"
+	"grpc-course/common/rpcerr"
+	"grpc-course/common/tracing"
 	"io"
 	"math"
 	"os"
@@ -11,8 +15,6 @@ import (
 
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
 func init() {
@@ -23,7 +25,19 @@ func init() {
 func main() {
 	log.Infof("Setting up client...")
 
-	cc, err := grpc.Dial("localhost:80", grpc.WithInsecure())
+	shutdownTracing, err := tracing.Setup(context.Background(), tracing.ConfigFromEnv(), "calc_cli")
+	if err != nil {
+		log.Fatalf("Error setting up tracing: %v", err)
+	}
+	defer shutdownTracing(context.Background())
+
+	opts := append([]grpc.DialOption{grpc.WithInsecure()}, tracing.DialOptions()...)
+	if token := os.Getenv("CALC_TOKEN"); token != "" {
+		// The proxy is plaintext, so the token has to be sent without TLS
+		opts = append(opts, grpc.WithPerRPCCredentials(&auth.TokenCredentials{Token: token, Insecure: true}))
+	}
+
+	cc, err := grpc.Dial("localhost:80", opts...)
 	if err != nil {
 		log.Fatalf("Error dialing: %s", err)
 	}
@@ -36,44 +50,70 @@ func main() {
 	doClientStreamCall(c)
 	doBiDirStreamCall(c)
 	doErrorUnary(c)
+	doBigCall(c)
+}
+
+func doBigCall(c calcpb.CalculatorClient) {
+	log.Info("Calling calc big divide...")
+
+	req := &calcpb.BigRequest{
+		X: "1",
+		Y: "3",
+		Options: &calcpb.BigOptions{
+			Kind:      calcpb.BigOptions_FLOAT,
+			Precision: 128,
+			Rounding:  calcpb.BigOptions_TO_ZERO,
+		},
+	}
+
+	resp, err := c.BigDivide(context.Background(), req)
+	if err != nil {
+		log.Fatalf("Error while calling calc big divide: %v", err)
+	}
+
+	log.Infof("Response from calc big divide: %s", resp)
 }
 
 func doErrorUnary(c calcpb.CalculatorClient) {
 	log.Info("Calling calc unary error with a positive number...")
 	resp, err := c.SquareRoot(context.Background(), &calcpb.SquareRootRequest{Number: 225})
 	if err != nil {
-		responseError, ok := status.FromError(err)
-		if ok {
-			// actual error form grpc (user error)
-			fmt.Println(responseError.Message())
-			fmt.Println(responseError.Code())
-			if responseError.Code() == codes.InvalidArgument {
-				fmt.Println("We have probably sent a negative number!")
-			}
-		} else {
-			log.Fatalf("Big error calling squareroot: %v", err)
-		}
+		printError(err)
 	}
 	log.Infof("Response from calc unary: %s", resp)
 
 	log.Info("Calling calc unary error with a negative number...")
 	respTwo, err := c.SquareRoot(context.Background(), &calcpb.SquareRootRequest{Number: -12})
 	if err != nil {
-		responseError, ok := status.FromError(err)
-		if ok {
-			// actual error form grpc (user error)
-			fmt.Println(responseError.Message())
-			fmt.Println(responseError.Code())
-			if responseError.Code() == codes.InvalidArgument {
-				fmt.Println("We have probably sent a negative number!")
-			}
-		} else {
-			log.Fatalf("Big error calling squareroot: %v", err)
-		}
+		printError(err)
 	}
 	log.Infof("Response from calc unary: %s", respTwo)
 }
 
+// printError prints the details of an error returned by the server.
+func printError(err error) {
+	err = rpcerr.Decode(err)
+	var rpcErr *rpcerr.Error
+	if !errors.As(err, &rpcErr) {
+		log.Fatalf("Big error calling squareroot: %v", err)
+	}
+	// actual error form grpc (user error)
+	fmt.Println(rpcErr.Message)
+	fmt.Println(rpcErr.Code, rpcErr.Reason)
+	var badRequest *rpcerr.BadRequestError
+	if errors.As(err, &badRequest) {
+		for _, v := range badRequest.Violations {
+			fmt.Printf("Invalid %s: %s\n", v.Field, v.Description)
+		}
+	}
+	if rpcErr.RetryDelay > 0 {
+		fmt.Printf("Retry in %v\n", rpcErr.RetryDelay)
+	}
+	for _, l := range rpcErr.Links {
+		fmt.Printf("%s: %s\n", l.Description, l.URL)
+	}
+}
+
 func doUnaryCall(c calcpb.CalculatorClient) {
 	log.Info("Calling calc unary...")
 
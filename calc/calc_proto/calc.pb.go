@@ -6,11 +6,16 @@ package calcpb
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
 	proto "github.com/golang/protobuf/proto"
+	any "github.com/golang/protobuf/ptypes/any"
+	duration "github.com/golang/protobuf/ptypes/duration"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
+	status "google.golang.org/genproto/googleapis/rpc/status"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
-	status "google.golang.org/grpc/status"
+	status1 "google.golang.org/grpc/status"
 	math "math"
 )
 
@@ -25,6 +30,139 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+type AggregateOptions_Aggregation int32
+
+const (
+	AggregateOptions_MAX  AggregateOptions_Aggregation = 0
+	AggregateOptions_MIN  AggregateOptions_Aggregation = 1
+	AggregateOptions_SUM  AggregateOptions_Aggregation = 2
+	AggregateOptions_MEAN AggregateOptions_Aggregation = 3
+)
+
+var AggregateOptions_Aggregation_name = map[int32]string{
+	0: "MAX",
+	1: "MIN",
+	2: "SUM",
+	3: "MEAN",
+}
+
+var AggregateOptions_Aggregation_value = map[string]int32{
+	"MAX":  0,
+	"MIN":  1,
+	"SUM":  2,
+	"MEAN": 3,
+}
+
+func (x AggregateOptions_Aggregation) String() string {
+	return proto.EnumName(AggregateOptions_Aggregation_name, int32(x))
+}
+
+func (AggregateOptions_Aggregation) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_62affd3053f75dd3, []int{2, 0}
+}
+
+type AggregateOptions_Emission int32
+
+const (
+	// After every number that changes the aggregate and, for time windows,
+	// when the expiry of numbers changes it.
+	AggregateOptions_ON_CHANGE AggregateOptions_Emission = 0
+	// After every number.
+	AggregateOptions_EVERY_MESSAGE AggregateOptions_Emission = 1
+	// Every emit_interval.
+	AggregateOptions_PERIODIC AggregateOptions_Emission = 2
+)
+
+var AggregateOptions_Emission_name = map[int32]string{
+	0: "ON_CHANGE",
+	1: "EVERY_MESSAGE",
+	2: "PERIODIC",
+}
+
+var AggregateOptions_Emission_value = map[string]int32{
+	"ON_CHANGE":     0,
+	"EVERY_MESSAGE": 1,
+	"PERIODIC":      2,
+}
+
+func (x AggregateOptions_Emission) String() string {
+	return proto.EnumName(AggregateOptions_Emission_name, int32(x))
+}
+
+func (AggregateOptions_Emission) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_62affd3053f75dd3, []int{2, 1}
+}
+
+type BigOptions_Kind int32
+
+const (
+	// Integers, e.g. "-42".
+	BigOptions_INTEGER BigOptions_Kind = 0
+	// Exact rationals, written as decimals, e.g. "0.1", or fractions, e.g.
+	// "1/3". Results that are not integers are fractions.
+	BigOptions_RATIONAL BigOptions_Kind = 1
+	// Binary floating-point numbers of precision bits, written as decimals,
+	// e.g. "1.5e-3".
+	BigOptions_FLOAT BigOptions_Kind = 2
+)
+
+var BigOptions_Kind_name = map[int32]string{
+	0: "INTEGER",
+	1: "RATIONAL",
+	2: "FLOAT",
+}
+
+var BigOptions_Kind_value = map[string]int32{
+	"INTEGER":  0,
+	"RATIONAL": 1,
+	"FLOAT":    2,
+}
+
+func (x BigOptions_Kind) String() string {
+	return proto.EnumName(BigOptions_Kind_name, int32(x))
+}
+
+func (BigOptions_Kind) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_62affd3053f75dd3, []int{57, 0}
+}
+
+type BigOptions_RoundingMode int32
+
+const (
+	BigOptions_TO_NEAREST_EVEN BigOptions_RoundingMode = 0
+	BigOptions_TO_NEAREST_AWAY BigOptions_RoundingMode = 1
+	BigOptions_TO_ZERO         BigOptions_RoundingMode = 2
+	BigOptions_AWAY_FROM_ZERO  BigOptions_RoundingMode = 3
+	BigOptions_TO_NEGATIVE_INF BigOptions_RoundingMode = 4
+	BigOptions_TO_POSITIVE_INF BigOptions_RoundingMode = 5
+)
+
+var BigOptions_RoundingMode_name = map[int32]string{
+	0: "TO_NEAREST_EVEN",
+	1: "TO_NEAREST_AWAY",
+	2: "TO_ZERO",
+	3: "AWAY_FROM_ZERO",
+	4: "TO_NEGATIVE_INF",
+	5: "TO_POSITIVE_INF",
+}
+
+var BigOptions_RoundingMode_value = map[string]int32{
+	"TO_NEAREST_EVEN": 0,
+	"TO_NEAREST_AWAY": 1,
+	"TO_ZERO":         2,
+	"AWAY_FROM_ZERO":  3,
+	"TO_NEGATIVE_INF": 4,
+	"TO_POSITIVE_INF": 5,
+}
+
+func (x BigOptions_RoundingMode) String() string {
+	return proto.EnumName(BigOptions_RoundingMode_name, int32(x))
+}
+
+func (BigOptions_RoundingMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_62affd3053f75dd3, []int{57, 1}
+}
+
 type FindMaxRequest struct {
 	Number               int64    `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -103,6 +241,210 @@ func (m *FindMaxResponse) GetNumber() int64 {
 	return 0
 }
 
+type AggregateOptions struct {
+	Aggregation AggregateOptions_Aggregation `protobuf:"varint,1,opt,name=aggregation,proto3,enum=calc.AggregateOptions_Aggregation" json:"aggregation,omitempty"`
+	// Aggregates the last window_size numbers, at most 65536. Exactly one of
+	// window_size and window_duration must be set.
+	WindowSize uint32 `protobuf:"varint,2,opt,name=window_size,json=windowSize,proto3" json:"window_size,omitempty"`
+	// Aggregates the numbers received during the last window_duration. The
+	// stream fails once more than 65536 of them would be in the window.
+	WindowDuration *duration.Duration        `protobuf:"bytes,3,opt,name=window_duration,json=windowDuration,proto3" json:"window_duration,omitempty"`
+	Emission       AggregateOptions_Emission `protobuf:"varint,4,opt,name=emission,proto3,enum=calc.AggregateOptions_Emission" json:"emission,omitempty"`
+	// Interval of PERIODIC emissions, at least 10ms.
+	EmitInterval         *duration.Duration `protobuf:"bytes,5,opt,name=emit_interval,json=emitInterval,proto3" json:"emit_interval,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *AggregateOptions) Reset()         { *m = AggregateOptions{} }
+func (m *AggregateOptions) String() string { return proto.CompactTextString(m) }
+func (*AggregateOptions) ProtoMessage()    {}
+func (*AggregateOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62affd3053f75dd3, []int{2}
+}
+
+func (m *AggregateOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AggregateOptions.Unmarshal(m, b)
+}
+func (m *AggregateOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AggregateOptions.Marshal(b, m, deterministic)
+}
+func (m *AggregateOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AggregateOptions.Merge(m, src)
+}
+func (m *AggregateOptions) XXX_Size() int {
+	return xxx_messageInfo_AggregateOptions.Size(m)
+}
+func (m *AggregateOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_AggregateOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AggregateOptions proto.InternalMessageInfo
+
+func (m *AggregateOptions) GetAggregation() AggregateOptions_Aggregation {
+	if m != nil {
+		return m.Aggregation
+	}
+	return AggregateOptions_MAX
+}
+
+func (m *AggregateOptions) GetWindowSize() uint32 {
+	if m != nil {
+		return m.WindowSize
+	}
+	return 0
+}
+
+func (m *AggregateOptions) GetWindowDuration() *duration.Duration {
+	if m != nil {
+		return m.WindowDuration
+	}
+	return nil
+}
+
+func (m *AggregateOptions) GetEmission() AggregateOptions_Emission {
+	if m != nil {
+		return m.Emission
+	}
+	return AggregateOptions_ON_CHANGE
+}
+
+func (m *AggregateOptions) GetEmitInterval() *duration.Duration {
+	if m != nil {
+		return m.EmitInterval
+	}
+	return nil
+}
+
+type AggregateRequest struct {
+	// Types that are valid to be assigned to Request:
+	//	*AggregateRequest_Options
+	//	*AggregateRequest_Number
+	Request              isAggregateRequest_Request `protobuf_oneof:"request"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *AggregateRequest) Reset()         { *m = AggregateRequest{} }
+func (m *AggregateRequest) String() string { return proto.CompactTextString(m) }
+func (*AggregateRequest) ProtoMessage()    {}
+func (*AggregateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62affd3053f75dd3, []int{3}
+}
+
+func (m *AggregateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AggregateRequest.Unmarshal(m, b)
+}
+func (m *AggregateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AggregateRequest.Marshal(b, m, deterministic)
+}
+func (m *AggregateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AggregateRequest.Merge(m, src)
+}
+func (m *AggregateRequest) XXX_Size() int {
+	return xxx_messageInfo_AggregateRequest.Size(m)
+}
+func (m *AggregateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AggregateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AggregateRequest proto.InternalMessageInfo
+
+type isAggregateRequest_Request interface {
+	isAggregateRequest_Request()
+}
+
+type AggregateRequest_Options struct {
+	Options *AggregateOptions `protobuf:"bytes,1,opt,name=options,proto3,oneof"`
+}
+
+type AggregateRequest_Number struct {
+	Number float64 `protobuf:"fixed64,2,opt,name=number,proto3,oneof"`
+}
+
+func (*AggregateRequest_Options) isAggregateRequest_Request() {}
+
+func (*AggregateRequest_Number) isAggregateRequest_Request() {}
+
+func (m *AggregateRequest) GetRequest() isAggregateRequest_Request {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+func (m *AggregateRequest) GetOptions() *AggregateOptions {
+	if x, ok := m.GetRequest().(*AggregateRequest_Options); ok {
+		return x.Options
+	}
+	return nil
+}
+
+func (m *AggregateRequest) GetNumber() float64 {
+	if x, ok := m.GetRequest().(*AggregateRequest_Number); ok {
+		return x.Number
+	}
+	return 0
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*AggregateRequest) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*AggregateRequest_Options)(nil),
+		(*AggregateRequest_Number)(nil),
+	}
+}
+
+type AggregateResponse struct {
+	Value float64 `protobuf:"fixed64,1,opt,name=value,proto3" json:"value,omitempty"`
+	// Numbers in the window, 0 once all of them expired.
+	Count                uint32   `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AggregateResponse) Reset()         { *m = AggregateResponse{} }
+func (m *AggregateResponse) String() string { return proto.CompactTextString(m) }
+func (*AggregateResponse) ProtoMessage()    {}
+func (*AggregateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62affd3053f75dd3, []int{4}
+}
+
+func (m *AggregateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AggregateResponse.Unmarshal(m, b)
+}
+func (m *AggregateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AggregateResponse.Marshal(b, m, deterministic)
+}
+func (m *AggregateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AggregateResponse.Merge(m, src)
+}
+func (m *AggregateResponse) XXX_Size() int {
+	return xxx_messageInfo_AggregateResponse.Size(m)
+}
+func (m *AggregateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AggregateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AggregateResponse proto.InternalMessageInfo
+
+func (m *AggregateResponse) GetValue() float64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+func (m *AggregateResponse) GetCount() uint32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
 type CalculateAverageRequest struct {
 	Number               float64  `protobuf:"fixed64,1,opt,name=number,proto3" json:"number,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -114,7 +456,7 @@ func (m *CalculateAverageRequest) Reset()         { *m = CalculateAverageRequest
 func (m *CalculateAverageRequest) String() string { return proto.CompactTextString(m) }
 func (*CalculateAverageRequest) ProtoMessage()    {}
 func (*CalculateAverageRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62affd3053f75dd3, []int{2}
+	return fileDescriptor_62affd3053f75dd3, []int{5}
 }
 
 func (m *CalculateAverageRequest) XXX_Unmarshal(b []byte) error {
@@ -153,7 +495,7 @@ func (m *CalculateAverageResponse) Reset()         { *m = CalculateAverageRespon
 func (m *CalculateAverageResponse) String() string { return proto.CompactTextString(m) }
 func (*CalculateAverageResponse) ProtoMessage()    {}
 func (*CalculateAverageResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62affd3053f75dd3, []int{3}
+	return fileDescriptor_62affd3053f75dd3, []int{6}
 }
 
 func (m *CalculateAverageResponse) XXX_Unmarshal(b []byte) error {
@@ -181,9 +523,165 @@ func (m *CalculateAverageResponse) GetAverage() float64 {
 	return 0
 }
 
+type CalculateStatisticsRequest struct {
+	Number               float64  `protobuf:"fixed64,1,opt,name=number,proto3" json:"number,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CalculateStatisticsRequest) Reset()         { *m = CalculateStatisticsRequest{} }
+func (m *CalculateStatisticsRequest) String() string { return proto.CompactTextString(m) }
+func (*CalculateStatisticsRequest) ProtoMessage()    {}
+func (*CalculateStatisticsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62affd3053f75dd3, []int{7}
+}
+
+func (m *CalculateStatisticsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CalculateStatisticsRequest.Unmarshal(m, b)
+}
+func (m *CalculateStatisticsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CalculateStatisticsRequest.Marshal(b, m, deterministic)
+}
+func (m *CalculateStatisticsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CalculateStatisticsRequest.Merge(m, src)
+}
+func (m *CalculateStatisticsRequest) XXX_Size() int {
+	return xxx_messageInfo_CalculateStatisticsRequest.Size(m)
+}
+func (m *CalculateStatisticsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CalculateStatisticsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CalculateStatisticsRequest proto.InternalMessageInfo
+
+func (m *CalculateStatisticsRequest) GetNumber() float64 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+type CalculateStatisticsResponse struct {
+	Count int64   `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Mean  float64 `protobuf:"fixed64,2,opt,name=mean,proto3" json:"mean,omitempty"`
+	// Unbiased sample variance, 0 for a single number.
+	Variance          float64 `protobuf:"fixed64,3,opt,name=variance,proto3" json:"variance,omitempty"`
+	StandardDeviation float64 `protobuf:"fixed64,4,opt,name=standard_deviation,json=standardDeviation,proto3" json:"standard_deviation,omitempty"`
+	Min               float64 `protobuf:"fixed64,5,opt,name=min,proto3" json:"min,omitempty"`
+	Max               float64 `protobuf:"fixed64,6,opt,name=max,proto3" json:"max,omitempty"`
+	Median            float64 `protobuf:"fixed64,7,opt,name=median,proto3" json:"median,omitempty"`
+	P90               float64 `protobuf:"fixed64,8,opt,name=p90,proto3" json:"p90,omitempty"`
+	P99               float64 `protobuf:"fixed64,9,opt,name=p99,proto3" json:"p99,omitempty"`
+	// Whether median, p90 and p99 are estimates.
+	Approximate          bool     `protobuf:"varint,10,opt,name=approximate,proto3" json:"approximate,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CalculateStatisticsResponse) Reset()         { *m = CalculateStatisticsResponse{} }
+func (m *CalculateStatisticsResponse) String() string { return proto.CompactTextString(m) }
+func (*CalculateStatisticsResponse) ProtoMessage()    {}
+func (*CalculateStatisticsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62affd3053f75dd3, []int{8}
+}
+
+func (m *CalculateStatisticsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CalculateStatisticsResponse.Unmarshal(m, b)
+}
+func (m *CalculateStatisticsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CalculateStatisticsResponse.Marshal(b, m, deterministic)
+}
+func (m *CalculateStatisticsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CalculateStatisticsResponse.Merge(m, src)
+}
+func (m *CalculateStatisticsResponse) XXX_Size() int {
+	return xxx_messageInfo_CalculateStatisticsResponse.Size(m)
+}
+func (m *CalculateStatisticsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CalculateStatisticsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CalculateStatisticsResponse proto.InternalMessageInfo
+
+func (m *CalculateStatisticsResponse) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *CalculateStatisticsResponse) GetMean() float64 {
+	if m != nil {
+		return m.Mean
+	}
+	return 0
+}
+
+func (m *CalculateStatisticsResponse) GetVariance() float64 {
+	if m != nil {
+		return m.Variance
+	}
+	return 0
+}
+
+func (m *CalculateStatisticsResponse) GetStandardDeviation() float64 {
+	if m != nil {
+		return m.StandardDeviation
+	}
+	return 0
+}
+
+func (m *CalculateStatisticsResponse) GetMin() float64 {
+	if m != nil {
+		return m.Min
+	}
+	return 0
+}
+
+func (m *CalculateStatisticsResponse) GetMax() float64 {
+	if m != nil {
+		return m.Max
+	}
+	return 0
+}
+
+func (m *CalculateStatisticsResponse) GetMedian() float64 {
+	if m != nil {
+		return m.Median
+	}
+	return 0
+}
+
+func (m *CalculateStatisticsResponse) GetP90() float64 {
+	if m != nil {
+		return m.P90
+	}
+	return 0
+}
+
+func (m *CalculateStatisticsResponse) GetP99() float64 {
+	if m != nil {
+		return m.P99
+	}
+	return 0
+}
+
+func (m *CalculateStatisticsResponse) GetApproximate() bool {
+	if m != nil {
+		return m.Approximate
+	}
+	return false
+}
+
 type CalculateSumRequest struct {
-	X                    int64    `protobuf:"varint,1,opt,name=x,proto3" json:"x,omitempty"`
-	Y                    int64    `protobuf:"varint,2,opt,name=y,proto3" json:"y,omitempty"`
+	X int64 `protobuf:"varint,1,opt,name=x,proto3" json:"x,omitempty"`
+	// The register of the session holding x, instead of x.
+	XRegister string `protobuf:"bytes,3,opt,name=x_register,json=xRegister,proto3" json:"x_register,omitempty"`
+	Y         int64  `protobuf:"varint,2,opt,name=y,proto3" json:"y,omitempty"`
+	// The register of the session holding y, instead of y.
+	YRegister            string   `protobuf:"bytes,4,opt,name=y_register,json=yRegister,proto3" json:"y_register,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -193,7 +691,7 @@ func (m *CalculateSumRequest) Reset()         { *m = CalculateSumRequest{} }
 func (m *CalculateSumRequest) String() string { return proto.CompactTextString(m) }
 func (*CalculateSumRequest) ProtoMessage()    {}
 func (*CalculateSumRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62affd3053f75dd3, []int{4}
+	return fileDescriptor_62affd3053f75dd3, []int{9}
 }
 
 func (m *CalculateSumRequest) XXX_Unmarshal(b []byte) error {
@@ -221,6 +719,13 @@ func (m *CalculateSumRequest) GetX() int64 {
 	return 0
 }
 
+func (m *CalculateSumRequest) GetXRegister() string {
+	if m != nil {
+		return m.XRegister
+	}
+	return ""
+}
+
 func (m *CalculateSumRequest) GetY() int64 {
 	if m != nil {
 		return m.Y
@@ -228,6 +733,13 @@ func (m *CalculateSumRequest) GetY() int64 {
 	return 0
 }
 
+func (m *CalculateSumRequest) GetYRegister() string {
+	if m != nil {
+		return m.YRegister
+	}
+	return ""
+}
+
 type CalculateSumResponse struct {
 	Result               int64    `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -239,7 +751,7 @@ func (m *CalculateSumResponse) Reset()         { *m = CalculateSumResponse{} }
 func (m *CalculateSumResponse) String() string { return proto.CompactTextString(m) }
 func (*CalculateSumResponse) ProtoMessage()    {}
 func (*CalculateSumResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62affd3053f75dd3, []int{5}
+	return fileDescriptor_62affd3053f75dd3, []int{10}
 }
 
 func (m *CalculateSumResponse) XXX_Unmarshal(b []byte) error {
@@ -267,352 +779,3506 @@ func (m *CalculateSumResponse) GetResult() int64 {
 	return 0
 }
 
-type PrimeDecomposeRequest struct {
-	Number               int64    `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+type SubtractRequest struct {
+	X int64 `protobuf:"varint,1,opt,name=x,proto3" json:"x,omitempty"`
+	// The register of the session holding x, instead of x.
+	XRegister string `protobuf:"bytes,3,opt,name=x_register,json=xRegister,proto3" json:"x_register,omitempty"`
+	Y         int64  `protobuf:"varint,2,opt,name=y,proto3" json:"y,omitempty"`
+	// The register of the session holding y, instead of y.
+	YRegister            string   `protobuf:"bytes,4,opt,name=y_register,json=yRegister,proto3" json:"y_register,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PrimeDecomposeRequest) Reset()         { *m = PrimeDecomposeRequest{} }
-func (m *PrimeDecomposeRequest) String() string { return proto.CompactTextString(m) }
-func (*PrimeDecomposeRequest) ProtoMessage()    {}
-func (*PrimeDecomposeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62affd3053f75dd3, []int{6}
+func (m *SubtractRequest) Reset()         { *m = SubtractRequest{} }
+func (m *SubtractRequest) String() string { return proto.CompactTextString(m) }
+func (*SubtractRequest) ProtoMessage()    {}
+func (*SubtractRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62affd3053f75dd3, []int{11}
 }
 
-func (m *PrimeDecomposeRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PrimeDecomposeRequest.Unmarshal(m, b)
+func (m *SubtractRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubtractRequest.Unmarshal(m, b)
 }
-func (m *PrimeDecomposeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PrimeDecomposeRequest.Marshal(b, m, deterministic)
+func (m *SubtractRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubtractRequest.Marshal(b, m, deterministic)
 }
-func (m *PrimeDecomposeRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PrimeDecomposeRequest.Merge(m, src)
+func (m *SubtractRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubtractRequest.Merge(m, src)
 }
-func (m *PrimeDecomposeRequest) XXX_Size() int {
-	return xxx_messageInfo_PrimeDecomposeRequest.Size(m)
+func (m *SubtractRequest) XXX_Size() int {
+	return xxx_messageInfo_SubtractRequest.Size(m)
 }
-func (m *PrimeDecomposeRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_PrimeDecomposeRequest.DiscardUnknown(m)
+func (m *SubtractRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubtractRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PrimeDecomposeRequest proto.InternalMessageInfo
+var xxx_messageInfo_SubtractRequest proto.InternalMessageInfo
 
-func (m *PrimeDecomposeRequest) GetNumber() int64 {
+func (m *SubtractRequest) GetX() int64 {
 	if m != nil {
-		return m.Number
+		return m.X
 	}
 	return 0
 }
 
-type PrimeDecomposeResponse struct {
-	Number               int64    `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+func (m *SubtractRequest) GetXRegister() string {
+	if m != nil {
+		return m.XRegister
+	}
+	return ""
+}
+
+func (m *SubtractRequest) GetY() int64 {
+	if m != nil {
+		return m.Y
+	}
+	return 0
+}
+
+func (m *SubtractRequest) GetYRegister() string {
+	if m != nil {
+		return m.YRegister
+	}
+	return ""
+}
+
+type SubtractResponse struct {
+	Result               int64    `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PrimeDecomposeResponse) Reset()         { *m = PrimeDecomposeResponse{} }
-func (m *PrimeDecomposeResponse) String() string { return proto.CompactTextString(m) }
-func (*PrimeDecomposeResponse) ProtoMessage()    {}
-func (*PrimeDecomposeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62affd3053f75dd3, []int{7}
+func (m *SubtractResponse) Reset()         { *m = SubtractResponse{} }
+func (m *SubtractResponse) String() string { return proto.CompactTextString(m) }
+func (*SubtractResponse) ProtoMessage()    {}
+func (*SubtractResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62affd3053f75dd3, []int{12}
 }
 
-func (m *PrimeDecomposeResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PrimeDecomposeResponse.Unmarshal(m, b)
+func (m *SubtractResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubtractResponse.Unmarshal(m, b)
 }
-func (m *PrimeDecomposeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PrimeDecomposeResponse.Marshal(b, m, deterministic)
+func (m *SubtractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubtractResponse.Marshal(b, m, deterministic)
 }
-func (m *PrimeDecomposeResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PrimeDecomposeResponse.Merge(m, src)
+func (m *SubtractResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubtractResponse.Merge(m, src)
 }
-func (m *PrimeDecomposeResponse) XXX_Size() int {
-	return xxx_messageInfo_PrimeDecomposeResponse.Size(m)
+func (m *SubtractResponse) XXX_Size() int {
+	return xxx_messageInfo_SubtractResponse.Size(m)
 }
-func (m *PrimeDecomposeResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_PrimeDecomposeResponse.DiscardUnknown(m)
+func (m *SubtractResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubtractResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PrimeDecomposeResponse proto.InternalMessageInfo
+var xxx_messageInfo_SubtractResponse proto.InternalMessageInfo
 
-func (m *PrimeDecomposeResponse) GetNumber() int64 {
+func (m *SubtractResponse) GetResult() int64 {
 	if m != nil {
-		return m.Number
+		return m.Result
 	}
 	return 0
 }
 
-type SquareRootRequest struct {
-	Number               int64    `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+type MultiplyRequest struct {
+	X int64 `protobuf:"varint,1,opt,name=x,proto3" json:"x,omitempty"`
+	// The register of the session holding x, instead of x.
+	XRegister string `protobuf:"bytes,3,opt,name=x_register,json=xRegister,proto3" json:"x_register,omitempty"`
+	Y         int64  `protobuf:"varint,2,opt,name=y,proto3" json:"y,omitempty"`
+	// The register of the session holding y, instead of y.
+	YRegister            string   `protobuf:"bytes,4,opt,name=y_register,json=yRegister,proto3" json:"y_register,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SquareRootRequest) Reset()         { *m = SquareRootRequest{} }
-func (m *SquareRootRequest) String() string { return proto.CompactTextString(m) }
-func (*SquareRootRequest) ProtoMessage()    {}
-func (*SquareRootRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62affd3053f75dd3, []int{8}
+func (m *MultiplyRequest) Reset()         { *m = MultiplyRequest{} }
+func (m *MultiplyRequest) String() string { return proto.CompactTextString(m) }
+func (*MultiplyRequest) ProtoMessage()    {}
+func (*MultiplyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62affd3053f75dd3, []int{13}
 }
 
-func (m *SquareRootRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SquareRootRequest.Unmarshal(m, b)
+func (m *MultiplyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MultiplyRequest.Unmarshal(m, b)
 }
-func (m *SquareRootRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SquareRootRequest.Marshal(b, m, deterministic)
+func (m *MultiplyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MultiplyRequest.Marshal(b, m, deterministic)
 }
-func (m *SquareRootRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SquareRootRequest.Merge(m, src)
+func (m *MultiplyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiplyRequest.Merge(m, src)
 }
-func (m *SquareRootRequest) XXX_Size() int {
-	return xxx_messageInfo_SquareRootRequest.Size(m)
+func (m *MultiplyRequest) XXX_Size() int {
+	return xxx_messageInfo_MultiplyRequest.Size(m)
 }
-func (m *SquareRootRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_SquareRootRequest.DiscardUnknown(m)
+func (m *MultiplyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiplyRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SquareRootRequest proto.InternalMessageInfo
+var xxx_messageInfo_MultiplyRequest proto.InternalMessageInfo
 
-func (m *SquareRootRequest) GetNumber() int64 {
+func (m *MultiplyRequest) GetX() int64 {
 	if m != nil {
-		return m.Number
+		return m.X
 	}
 	return 0
 }
 
-type SquareRootResponse struct {
-	Result               float64  `protobuf:"fixed64,1,opt,name=result,proto3" json:"result,omitempty"`
+func (m *MultiplyRequest) GetXRegister() string {
+	if m != nil {
+		return m.XRegister
+	}
+	return ""
+}
+
+func (m *MultiplyRequest) GetY() int64 {
+	if m != nil {
+		return m.Y
+	}
+	return 0
+}
+
+func (m *MultiplyRequest) GetYRegister() string {
+	if m != nil {
+		return m.YRegister
+	}
+	return ""
+}
+
+type MultiplyResponse struct {
+	Result               int64    `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SquareRootResponse) Reset()         { *m = SquareRootResponse{} }
-func (m *SquareRootResponse) String() string { return proto.CompactTextString(m) }
-func (*SquareRootResponse) ProtoMessage()    {}
-func (*SquareRootResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62affd3053f75dd3, []int{9}
+func (m *MultiplyResponse) Reset()         { *m = MultiplyResponse{} }
+func (m *MultiplyResponse) String() string { return proto.CompactTextString(m) }
+func (*MultiplyResponse) ProtoMessage()    {}
+func (*MultiplyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62affd3053f75dd3, []int{14}
 }
 
-func (m *SquareRootResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SquareRootResponse.Unmarshal(m, b)
+func (m *MultiplyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MultiplyResponse.Unmarshal(m, b)
 }
-func (m *SquareRootResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SquareRootResponse.Marshal(b, m, deterministic)
+func (m *MultiplyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MultiplyResponse.Marshal(b, m, deterministic)
 }
-func (m *SquareRootResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SquareRootResponse.Merge(m, src)
+func (m *MultiplyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiplyResponse.Merge(m, src)
 }
-func (m *SquareRootResponse) XXX_Size() int {
-	return xxx_messageInfo_SquareRootResponse.Size(m)
+func (m *MultiplyResponse) XXX_Size() int {
+	return xxx_messageInfo_MultiplyResponse.Size(m)
 }
-func (m *SquareRootResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_SquareRootResponse.DiscardUnknown(m)
+func (m *MultiplyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiplyResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SquareRootResponse proto.InternalMessageInfo
+var xxx_messageInfo_MultiplyResponse proto.InternalMessageInfo
 
-func (m *SquareRootResponse) GetResult() float64 {
+func (m *MultiplyResponse) GetResult() int64 {
 	if m != nil {
 		return m.Result
 	}
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*FindMaxRequest)(nil), "calc.FindMaxRequest")
-	proto.RegisterType((*FindMaxResponse)(nil), "calc.FindMaxResponse")
-	proto.RegisterType((*CalculateAverageRequest)(nil), "calc.CalculateAverageRequest")
-	proto.RegisterType((*CalculateAverageResponse)(nil), "calc.CalculateAverageResponse")
-	proto.RegisterType((*CalculateSumRequest)(nil), "calc.CalculateSumRequest")
-	proto.RegisterType((*CalculateSumResponse)(nil), "calc.CalculateSumResponse")
-	proto.RegisterType((*PrimeDecomposeRequest)(nil), "calc.PrimeDecomposeRequest")
-	proto.RegisterType((*PrimeDecomposeResponse)(nil), "calc.PrimeDecomposeResponse")
-	proto.RegisterType((*SquareRootRequest)(nil), "calc.SquareRootRequest")
-	proto.RegisterType((*SquareRootResponse)(nil), "calc.SquareRootResponse")
+type DivideRequest struct {
+	X int64 `protobuf:"varint,1,opt,name=x,proto3" json:"x,omitempty"`
+	// The register of the session holding x, instead of x.
+	XRegister string `protobuf:"bytes,3,opt,name=x_register,json=xRegister,proto3" json:"x_register,omitempty"`
+	Y         int64  `protobuf:"varint,2,opt,name=y,proto3" json:"y,omitempty"`
+	// The register of the session holding y, instead of y.
+	YRegister            string   `protobuf:"bytes,4,opt,name=y_register,json=yRegister,proto3" json:"y_register,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func init() { proto.RegisterFile("calc/calc_proto/calc.proto", fileDescriptor_62affd3053f75dd3) }
-
-var fileDescriptor_62affd3053f75dd3 = []byte{
-	// 401 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0xcb, 0x4e, 0xea, 0x40,
-	0x18, 0x3e, 0x03, 0x27, 0x40, 0xfe, 0x70, 0x38, 0x3a, 0xdc, 0xea, 0x88, 0xc6, 0x74, 0x85, 0x97,
-	0x50, 0x50, 0x57, 0xc6, 0x0d, 0x6a, 0xdc, 0x19, 0x0d, 0xc4, 0x0d, 0x1b, 0x33, 0xd4, 0x09, 0x69,
-	0xd2, 0x76, 0x4a, 0x2f, 0x04, 0xb6, 0xbe, 0x81, 0xf1, 0xd1, 0x7c, 0x05, 0x1f, 0xc4, 0xb4, 0xd3,
-	0x01, 0x5a, 0x4a, 0xd8, 0x34, 0xf3, 0xcf, 0xff, 0x7d, 0xff, 0xe5, 0xeb, 0x37, 0x40, 0x74, 0x6a,
-	0xea, 0x5a, 0xf8, 0x79, 0x73, 0x5c, 0xee, 0xf3, 0xe8, 0xd8, 0x89, 0x8e, 0xf8, 0x6f, 0x78, 0x26,
-	0xad, 0x09, 0xe7, 0x13, 0x93, 0x69, 0xd4, 0x31, 0x34, 0x6a, 0xdb, 0xdc, 0xa7, 0xbe, 0xc1, 0x6d,
-	0x4f, 0x60, 0xd4, 0x36, 0x54, 0x1e, 0x0d, 0xfb, 0xfd, 0x89, 0xce, 0x07, 0x6c, 0x1a, 0x30, 0xcf,
-	0xc7, 0x0d, 0x28, 0xd8, 0x81, 0x35, 0x66, 0xae, 0x82, 0x4e, 0x50, 0x3b, 0x3f, 0x88, 0x23, 0xf5,
-	0x14, 0xfe, 0x2f, 0x91, 0x9e, 0xc3, 0x6d, 0x8f, 0x6d, 0x85, 0xf6, 0xa0, 0x79, 0x4f, 0x4d, 0x3d,
-	0x30, 0xa9, 0xcf, 0xfa, 0x33, 0xe6, 0xd2, 0x09, 0xcb, 0xae, 0x8e, 0x96, 0x94, 0x6b, 0x50, 0x36,
-	0x29, 0x71, 0x1b, 0x05, 0x8a, 0x54, 0x5c, 0xc5, 0x24, 0x19, 0xaa, 0x3d, 0xa8, 0x2e, 0x59, 0xc3,
-	0xc0, 0x92, 0x4d, 0xca, 0x80, 0xe6, 0xf1, 0x48, 0x68, 0x1e, 0x46, 0x0b, 0x25, 0x27, 0xa2, 0x85,
-	0xda, 0x81, 0x5a, 0x92, 0xb2, 0xda, 0xc5, 0x65, 0x5e, 0x60, 0xfa, 0x72, 0x17, 0x11, 0xa9, 0x1a,
-	0xd4, 0x5f, 0x5c, 0xc3, 0x62, 0x0f, 0x4c, 0xe7, 0x96, 0xc3, 0x3d, 0xb6, 0x4b, 0xa7, 0x2e, 0x34,
-	0xd2, 0x84, 0x1d, 0x72, 0x9d, 0xc3, 0xfe, 0x70, 0x1a, 0x50, 0x97, 0x0d, 0x38, 0xf7, 0x77, 0x95,
-	0xbf, 0x00, 0xbc, 0x0e, 0xce, 0x9c, 0x1e, 0xc9, 0xe9, 0x2f, 0x3f, 0xf3, 0x00, 0x72, 0x5d, 0xee,
-	0xe2, 0x11, 0x94, 0xd7, 0x97, 0xc7, 0x07, 0x9d, 0xc8, 0x2e, 0x19, 0x1a, 0x12, 0x92, 0x95, 0x12,
-	0xdd, 0xd4, 0xea, 0xc7, 0xf7, 0xcf, 0x57, 0xee, 0x9f, 0x5a, 0xd2, 0x66, 0xbd, 0xc8, 0x70, 0x37,
-	0xe8, 0x0c, 0x3f, 0x43, 0x25, 0xb9, 0x37, 0x3e, 0x14, 0x25, 0x32, 0xe5, 0x23, 0xad, 0xec, 0x64,
-	0xdc, 0xe1, 0x4f, 0x17, 0xe1, 0x57, 0xd8, 0x4b, 0x5b, 0x02, 0x1f, 0xa5, 0xa6, 0x4a, 0xba, 0x8b,
-	0x1c, 0x6f, 0x4b, 0xcb, 0xb2, 0x6d, 0x84, 0x6f, 0xa1, 0x18, 0xfb, 0x18, 0xd7, 0x04, 0x3c, 0xf9,
-	0x00, 0x48, 0x3d, 0x75, 0xbb, 0xe2, 0x76, 0x11, 0xee, 0x03, 0xac, 0xe4, 0xc7, 0x4d, 0x01, 0xdd,
-	0xf8, 0x7b, 0x44, 0xd9, 0x4c, 0xc8, 0x32, 0x77, 0xa5, 0x51, 0x21, 0x4c, 0x3a, 0xe3, 0x71, 0x21,
-	0x7a, 0x83, 0x57, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x90, 0xd8, 0x24, 0x85, 0xc5, 0x03, 0x00,
-	0x00,
+func (m *DivideRequest) Reset()         { *m = DivideRequest{} }
+func (m *DivideRequest) String() string { return proto.CompactTextString(m) }
+func (*DivideRequest) ProtoMessage()    {}
+func (*DivideRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62affd3053f75dd3, []int{15}
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *DivideRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DivideRequest.Unmarshal(m, b)
+}
+func (m *DivideRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DivideRequest.Marshal(b, m, deterministic)
+}
+func (m *DivideRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DivideRequest.Merge(m, src)
+}
+func (m *DivideRequest) XXX_Size() int {
+	return xxx_messageInfo_DivideRequest.Size(m)
+}
+func (m *DivideRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DivideRequest.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_DivideRequest proto.InternalMessageInfo
 
-// CalculatorClient is the client API for Calculator service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type CalculatorClient interface {
-	// Unary
-	CalculateSum(ctx context.Context, in *CalculateSumRequest, opts ...grpc.CallOption) (*CalculateSumResponse, error)
-	// Server stream
-	PrimeDecompose(ctx context.Context, in *PrimeDecomposeRequest, opts ...grpc.CallOption) (Calculator_PrimeDecomposeClient, error)
-	// Client stream
-	CalculateAverage(ctx context.Context, opts ...grpc.CallOption) (Calculator_CalculateAverageClient, error)
-	// Bi-directional stream
-	FindMax(ctx context.Context, opts ...grpc.CallOption) (Calculator_FindMaxClient, error)
-	// Calculates the square root of a given number.
-	//
-	// error handling
-	// This RPC will throw INVALID_ARGUMENT if the number in the request is
-	// negative.
-	SquareRoot(ctx context.Context, in *SquareRootRequest, opts ...grpc.CallOption) (*SquareRootResponse, error)
+func (m *DivideRequest) GetX() int64 {
+	if m != nil {
+		return m.X
+	}
+	return 0
 }
 
-type calculatorClient struct {
-	cc *grpc.ClientConn
+func (m *DivideRequest) GetXRegister() string {
+	if m != nil {
+		return m.XRegister
+	}
+	return ""
 }
 
-func NewCalculatorClient(cc *grpc.ClientConn) CalculatorClient {
-	return &calculatorClient{cc}
+func (m *DivideRequest) GetY() int64 {
+	if m != nil {
+		return m.Y
+	}
+	return 0
 }
 
-func (c *calculatorClient) CalculateSum(ctx context.Context, in *CalculateSumRequest, opts ...grpc.CallOption) (*CalculateSumResponse, error) {
-	out := new(CalculateSumResponse)
-	err := c.cc.Invoke(ctx, "/calc.Calculator/CalculateSum", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DivideRequest) GetYRegister() string {
+	if m != nil {
+		return m.YRegister
 	}
-	return out, nil
+	return ""
 }
 
-func (c *calculatorClient) PrimeDecompose(ctx context.Context, in *PrimeDecomposeRequest, opts ...grpc.CallOption) (Calculator_PrimeDecomposeClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Calculator_serviceDesc.Streams[0], "/calc.Calculator/PrimeDecompose", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &calculatorPrimeDecomposeClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	return x, nil
+type DivideResponse struct {
+	Quotient             int64    `protobuf:"varint,1,opt,name=quotient,proto3" json:"quotient,omitempty"`
+	Remainder            int64    `protobuf:"varint,2,opt,name=remainder,proto3" json:"remainder,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type Calculator_PrimeDecomposeClient interface {
-	Recv() (*PrimeDecomposeResponse, error)
-	grpc.ClientStream
+func (m *DivideResponse) Reset()         { *m = DivideResponse{} }
+func (m *DivideResponse) String() string { return proto.CompactTextString(m) }
+func (*DivideResponse) ProtoMessage()    {}
+func (*DivideResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62affd3053f75dd3, []int{16}
 }
 
-type calculatorPrimeDecomposeClient struct {
-	grpc.ClientStream
+func (m *DivideResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DivideResponse.Unmarshal(m, b)
+}
+func (m *DivideResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DivideResponse.Marshal(b, m, deterministic)
+}
+func (m *DivideResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DivideResponse.Merge(m, src)
+}
+func (m *DivideResponse) XXX_Size() int {
+	return xxx_messageInfo_DivideResponse.Size(m)
+}
+func (m *DivideResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DivideResponse.DiscardUnknown(m)
 }
 
-func (x *calculatorPrimeDecomposeClient) Recv() (*PrimeDecomposeResponse, error) {
-	m := new(PrimeDecomposeResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+var xxx_messageInfo_DivideResponse proto.InternalMessageInfo
+
+func (m *DivideResponse) GetQuotient() int64 {
+	if m != nil {
+		return m.Quotient
 	}
-	return m, nil
+	return 0
 }
 
-func (c *calculatorClient) CalculateAverage(ctx context.Context, opts ...grpc.CallOption) (Calculator_CalculateAverageClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Calculator_serviceDesc.Streams[1], "/calc.Calculator/CalculateAverage", opts...)
-	if err != nil {
-		return nil, err
+func (m *DivideResponse) GetRemainder() int64 {
+	if m != nil {
+		return m.Remainder
 	}
-	x := &calculatorCalculateAverageClient{stream}
-	return x, nil
+	return 0
 }
 
-type Calculator_CalculateAverageClient interface {
-	Send(*CalculateAverageRequest) error
-	CloseAndRecv() (*CalculateAverageResponse, error)
-	grpc.ClientStream
+type ModuloRequest struct {
+	X int64 `protobuf:"varint,1,opt,name=x,proto3" json:"x,omitempty"`
+	// The register of the session holding x, instead of x.
+	XRegister string `protobuf:"bytes,3,opt,name=x_register,json=xRegister,proto3" json:"x_register,omitempty"`
+	Y         int64  `protobuf:"varint,2,opt,name=y,proto3" json:"y,omitempty"`
+	// The register of the session holding y, instead of y.
+	YRegister            string   `protobuf:"bytes,4,opt,name=y_register,json=yRegister,proto3" json:"y_register,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type calculatorCalculateAverageClient struct {
-	grpc.ClientStream
+func (m *ModuloRequest) Reset()         { *m = ModuloRequest{} }
+func (m *ModuloRequest) String() string { return proto.CompactTextString(m) }
+func (*ModuloRequest) ProtoMessage()    {}
+func (*ModuloRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62affd3053f75dd3, []int{17}
 }
 
-func (x *calculatorCalculateAverageClient) Send(m *CalculateAverageRequest) error {
-	return x.ClientStream.SendMsg(m)
+func (m *ModuloRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ModuloRequest.Unmarshal(m, b)
+}
+func (m *ModuloRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ModuloRequest.Marshal(b, m, deterministic)
+}
+func (m *ModuloRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModuloRequest.Merge(m, src)
+}
+func (m *ModuloRequest) XXX_Size() int {
+	return xxx_messageInfo_ModuloRequest.Size(m)
+}
+func (m *ModuloRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModuloRequest.DiscardUnknown(m)
 }
 
-func (x *calculatorCalculateAverageClient) CloseAndRecv() (*CalculateAverageResponse, error) {
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+var xxx_messageInfo_ModuloRequest proto.InternalMessageInfo
+
+func (m *ModuloRequest) GetX() int64 {
+	if m != nil {
+		return m.X
 	}
-	m := new(CalculateAverageResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+	return 0
+}
+
+func (m *ModuloRequest) GetXRegister() string {
+	if m != nil {
+		return m.XRegister
 	}
-	return m, nil
+	return ""
 }
 
-func (c *calculatorClient) FindMax(ctx context.Context, opts ...grpc.CallOption) (Calculator_FindMaxClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Calculator_serviceDesc.Streams[2], "/calc.Calculator/FindMax", opts...)
-	if err != nil {
-		return nil, err
+func (m *ModuloRequest) GetY() int64 {
+	if m != nil {
+		return m.Y
 	}
-	x := &calculatorFindMaxClient{stream}
-	return x, nil
+	return 0
 }
 
-type Calculator_FindMaxClient interface {
-	Send(*FindMaxRequest) error
-	Recv() (*FindMaxResponse, error)
-	grpc.ClientStream
+func (m *ModuloRequest) GetYRegister() string {
+	if m != nil {
+		return m.YRegister
+	}
+	return ""
 }
 
-type calculatorFindMaxClient struct {
-	grpc.ClientStream
+type ModuloResponse struct {
+	Result               int64    `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (x *calculatorFindMaxClient) Send(m *FindMaxRequest) error {
-	return x.ClientStream.SendMsg(m)
+func (m *ModuloResponse) Reset()         { *m = ModuloResponse{} }
+func (m *ModuloResponse) String() string { return proto.CompactTextString(m) }
+func (*ModuloResponse) ProtoMessage()    {}
+func (*ModuloResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62affd3053f75dd3, []int{18}
 }
 
-func (x *calculatorFindMaxClient) Recv() (*FindMaxResponse, error) {
-	m := new(FindMaxResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
+func (m *ModuloResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ModuloResponse.Unmarshal(m, b)
+}
+func (m *ModuloResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ModuloResponse.Marshal(b, m, deterministic)
+}
+func (m *ModuloResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModuloResponse.Merge(m, src)
+}
+func (m *ModuloResponse) XXX_Size() int {
+	return xxx_messageInfo_ModuloResponse.Size(m)
+}
+func (m *ModuloResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModuloResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModuloResponse proto.InternalMessageInfo
+
+func (m *ModuloResponse) GetResult() int64 {
+	if m != nil {
+		return m.Result
+	}
+	return 0
+}
+
+type IntegerPowerRequest struct {
+	Base int64 `protobuf:"varint,1,opt,name=base,proto3" json:"base,omitempty"`
+	// The register of the session holding base, instead of base.
+	BaseRegister string `protobuf:"bytes,3,opt,name=base_register,json=baseRegister,proto3" json:"base_register,omitempty"`
+	Exponent     int64  `protobuf:"varint,2,opt,name=exponent,proto3" json:"exponent,omitempty"`
+	// The register of the session holding exponent, instead of exponent.
+	ExponentRegister     string   `protobuf:"bytes,4,opt,name=exponent_register,json=exponentRegister,proto3" json:"exponent_register,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IntegerPowerRequest) Reset()         { *m = IntegerPowerRequest{} }
+func (m *IntegerPowerRequest) String() string { return proto.CompactTextString(m) }
+func (*IntegerPowerRequest) ProtoMessage()    {}
+func (*IntegerPowerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62affd3053f75dd3, []int{19}
+}
+
+func (m *IntegerPowerRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IntegerPowerRequest.Unmarshal(m, b)
+}
+func (m *IntegerPowerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IntegerPowerRequest.Marshal(b, m, deterministic)
+}
+func (m *IntegerPowerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IntegerPowerRequest.Merge(m, src)
+}
+func (m *IntegerPowerRequest) XXX_Size() int {
+	return xxx_messageInfo_IntegerPowerRequest.Size(m)
+}
+func (m *IntegerPowerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_IntegerPowerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IntegerPowerRequest proto.InternalMessageInfo
+
+func (m *IntegerPowerRequest) GetBase() int64 {
+	if m != nil {
+		return m.Base
+	}
+	return 0
+}
+
+func (m *IntegerPowerRequest) GetBaseRegister() string {
+	if m != nil {
+		return m.BaseRegister
+	}
+	return ""
+}
+
+func (m *IntegerPowerRequest) GetExponent() int64 {
+	if m != nil {
+		return m.Exponent
+	}
+	return 0
+}
+
+func (m *IntegerPowerRequest) GetExponentRegister() string {
+	if m != nil {
+		return m.ExponentRegister
+	}
+	return ""
+}
+
+type IntegerPowerResponse struct {
+	Result               int64    `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IntegerPowerResponse) Reset()         { *m = IntegerPowerResponse{} }
+func (m *IntegerPowerResponse) String() string { return proto.CompactTextString(m) }
+func (*IntegerPowerResponse) ProtoMessage()    {}
+func (*IntegerPowerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62affd3053f75dd3, []int{20}
+}
+
+func (m *IntegerPowerResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IntegerPowerResponse.Unmarshal(m, b)
+}
+func (m *IntegerPowerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IntegerPowerResponse.Marshal(b, m, deterministic)
+}
+func (m *IntegerPowerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IntegerPowerResponse.Merge(m, src)
+}
+func (m *IntegerPowerResponse) XXX_Size() int {
+	return xxx_messageInfo_IntegerPowerResponse.Size(m)
+}
+func (m *IntegerPowerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_IntegerPowerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IntegerPowerResponse proto.InternalMessageInfo
+
+func (m *IntegerPowerResponse) GetResult() int64 {
+	if m != nil {
+		return m.Result
+	}
+	return 0
+}
+
+type PrimeDecomposeRequest struct {
+	Number               int64    `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PrimeDecomposeRequest) Reset()         { *m = PrimeDecomposeRequest{} }
+func (m *PrimeDecomposeRequest) String() string { return proto.CompactTextString(m) }
+func (*PrimeDecomposeRequest) ProtoMessage()    {}
+func (*PrimeDecomposeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62affd3053f75dd3, []int{21}
+}
+
+func (m *PrimeDecomposeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PrimeDecomposeRequest.Unmarshal(m, b)
+}
+func (m *PrimeDecomposeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PrimeDecomposeRequest.Marshal(b, m, deterministic)
+}
+func (m *PrimeDecomposeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrimeDecomposeRequest.Merge(m, src)
+}
+func (m *PrimeDecomposeRequest) XXX_Size() int {
+	return xxx_messageInfo_PrimeDecomposeRequest.Size(m)
+}
+func (m *PrimeDecomposeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrimeDecomposeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrimeDecomposeRequest proto.InternalMessageInfo
+
+func (m *PrimeDecomposeRequest) GetNumber() int64 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+type PrimeDecomposeResponse struct {
+	Number               int64    `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PrimeDecomposeResponse) Reset()         { *m = PrimeDecomposeResponse{} }
+func (m *PrimeDecomposeResponse) String() string { return proto.CompactTextString(m) }
+func (*PrimeDecomposeResponse) ProtoMessage()    {}
+func (*PrimeDecomposeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62affd3053f75dd3, []int{22}
+}
+
+func (m *PrimeDecomposeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PrimeDecomposeResponse.Unmarshal(m, b)
+}
+func (m *PrimeDecomposeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PrimeDecomposeResponse.Marshal(b, m, deterministic)
+}
+func (m *PrimeDecomposeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrimeDecomposeResponse.Merge(m, src)
+}
+func (m *PrimeDecomposeResponse) XXX_Size() int {
+	return xxx_messageInfo_PrimeDecomposeResponse.Size(m)
+}
+func (m *PrimeDecomposeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrimeDecomposeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrimeDecomposeResponse proto.InternalMessageInfo
+
+func (m *PrimeDecomposeResponse) GetNumber() int64 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+type PrimesInRangeRequest struct {
+	From                 int64    `protobuf:"varint,1,opt,name=from,proto3" json:"from,omitempty"`
+	To                   int64    `protobuf:"varint,2,opt,name=to,proto3" json:"to,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PrimesInRangeRequest) Reset()         { *m = PrimesInRangeRequest{} }
+func (m *PrimesInRangeRequest) String() string { return proto.CompactTextString(m) }
+func (*PrimesInRangeRequest) ProtoMessage()    {}
+func (*PrimesInRangeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62affd3053f75dd3, []int{23}
+}
+
+func (m *PrimesInRangeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PrimesInRangeRequest.Unmarshal(m, b)
+}
+func (m *PrimesInRangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PrimesInRangeRequest.Marshal(b, m, deterministic)
+}
+func (m *PrimesInRangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrimesInRangeRequest.Merge(m, src)
+}
+func (m *PrimesInRangeRequest) XXX_Size() int {
+	return xxx_messageInfo_PrimesInRangeRequest.Size(m)
+}
+func (m *PrimesInRangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrimesInRangeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrimesInRangeRequest proto.InternalMessageInfo
+
+func (m *PrimesInRangeRequest) GetFrom() int64 {
+	if m != nil {
+		return m.From
+	}
+	return 0
+}
+
+func (m *PrimesInRangeRequest) GetTo() int64 {
+	if m != nil {
+		return m.To
+	}
+	return 0
+}
+
+type PrimesInRangeResponse struct {
+	Number               int64    `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PrimesInRangeResponse) Reset()         { *m = PrimesInRangeResponse{} }
+func (m *PrimesInRangeResponse) String() string { return proto.CompactTextString(m) }
+func (*PrimesInRangeResponse) ProtoMessage()    {}
+func (*PrimesInRangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62affd3053f75dd3, []int{24}
+}
+
+func (m *PrimesInRangeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PrimesInRangeResponse.Unmarshal(m, b)
+}
+func (m *PrimesInRangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PrimesInRangeResponse.Marshal(b, m, deterministic)
+}
+func (m *PrimesInRangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrimesInRangeResponse.Merge(m, src)
+}
+func (m *PrimesInRangeResponse) XXX_Size() int {
+	return xxx_messageInfo_PrimesInRangeResponse.Size(m)
+}
+func (m *PrimesInRangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrimesInRangeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrimesInRangeResponse proto.InternalMessageInfo
+
+func (m *PrimesInRangeResponse) GetNumber() int64 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+type IsPrimeRequest struct {
+	Number               int64    `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IsPrimeRequest) Reset()         { *m = IsPrimeRequest{} }
+func (m *IsPrimeRequest) String() string { return proto.CompactTextString(m) }
+func (*IsPrimeRequest) ProtoMessage()    {}
+func (*IsPrimeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62affd3053f75dd3, []int{25}
+}
+
+func (m *IsPrimeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IsPrimeRequest.Unmarshal(m, b)
+}
+func (m *IsPrimeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IsPrimeRequest.Marshal(b, m, deterministic)
+}
+func (m *IsPrimeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IsPrimeRequest.Merge(m, src)
+}
+func (m *IsPrimeRequest) XXX_Size() int {
+	return xxx_messageInfo_IsPrimeRequest.Size(m)
+}
+func (m *IsPrimeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_IsPrimeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IsPrimeRequest proto.InternalMessageInfo
+
+func (m *IsPrimeRequest) GetNumber() int64 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+type IsPrimeResponse struct {
+	Prime                bool     `protobuf:"varint,1,opt,name=prime,proto3" json:"prime,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IsPrimeResponse) Reset()         { *m = IsPrimeResponse{} }
+func (m *IsPrimeResponse) String() string { return proto.CompactTextString(m) }
+func (*IsPrimeResponse) ProtoMessage()    {}
+func (*IsPrimeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62affd3053f75dd3, []int{26}
+}
+
+func (m *IsPrimeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IsPrimeResponse.Unmarshal(m, b)
+}
+func (m *IsPrimeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IsPrimeResponse.Marshal(b, m, deterministic)
+}
+func (m *IsPrimeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IsPrimeResponse.Merge(m, src)
+}
+func (m *IsPrimeResponse) XXX_Size() int {
+	return xxx_messageInfo_IsPrimeResponse.Size(m)
+}
+func (m *IsPrimeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_IsPrimeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IsPrimeResponse proto.InternalMessageInfo
+
+func (m *IsPrimeResponse) GetPrime() bool {
+	if m != nil {
+		return m.Prime
+	}
+	return false
+}
+
+type GreatestCommonDivisorRequest struct {
+	Numbers              []int64  `protobuf:"varint,1,rep,packed,name=numbers,proto3" json:"numbers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GreatestCommonDivisorRequest) Reset()         { *m = GreatestCommonDivisorRequest{} }
+func (m *GreatestCommonDivisorRequest) String() string { return proto.CompactTextString(m) }
+func (*GreatestCommonDivisorRequest) ProtoMessage()    {}
+func (*GreatestCommonDivisorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62affd3053f75dd3, []int{27}
+}
+
+func (m *GreatestCommonDivisorRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GreatestCommonDivisorRequest.Unmarshal(m, b)
+}
+func (m *GreatestCommonDivisorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GreatestCommonDivisorRequest.Marshal(b, m, deterministic)
+}
+func (m *GreatestCommonDivisorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GreatestCommonDivisorRequest.Merge(m, src)
+}
+func (m *GreatestCommonDivisorRequest) XXX_Size() int {
+	return xxx_messageInfo_GreatestCommonDivisorRequest.Size(m)
+}
+func (m *GreatestCommonDivisorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GreatestCommonDivisorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GreatestCommonDivisorRequest proto.InternalMessageInfo
+
+func (m *GreatestCommonDivisorRequest) GetNumbers() []int64 {
+	if m != nil {
+		return m.Numbers
+	}
+	return nil
+}
+
+type GreatestCommonDivisorResponse struct {
+	Result               int64    `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GreatestCommonDivisorResponse) Reset()         { *m = GreatestCommonDivisorResponse{} }
+func (m *GreatestCommonDivisorResponse) String() string { return proto.CompactTextString(m) }
+func (*GreatestCommonDivisorResponse) ProtoMessage()    {}
+func (*GreatestCommonDivisorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62affd3053f75dd3, []int{28}
+}
+
+func (m *GreatestCommonDivisorResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GreatestCommonDivisorResponse.Unmarshal(m, b)
+}
+func (m *GreatestCommonDivisorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GreatestCommonDivisorResponse.Marshal(b, m, deterministic)
+}
+func (m *GreatestCommonDivisorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GreatestCommonDivisorResponse.Merge(m, src)
+}
+func (m *GreatestCommonDivisorResponse) XXX_Size() int {
+	return xxx_messageInfo_GreatestCommonDivisorResponse.Size(m)
+}
+func (m *GreatestCommonDivisorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GreatestCommonDivisorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GreatestCommonDivisorResponse proto.InternalMessageInfo
+
+func (m *GreatestCommonDivisorResponse) GetResult() int64 {
+	if m != nil {
+		return m.Result
+	}
+	return 0
+}
+
+type LeastCommonMultipleRequest struct {
+	Numbers              []int64  `protobuf:"varint,1,rep,packed,name=numbers,proto3" json:"numbers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LeastCommonMultipleRequest) Reset()         { *m = LeastCommonMultipleRequest{} }
+func (m *LeastCommonMultipleRequest) String() string { return proto.CompactTextString(m) }
+func (*LeastCommonMultipleRequest) ProtoMessage()    {}
+func (*LeastCommonMultipleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62affd3053f75dd3, []int{29}
+}
+
+func (m *LeastCommonMultipleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LeastCommonMultipleRequest.Unmarshal(m, b)
+}
+func (m *LeastCommonMultipleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LeastCommonMultipleRequest.Marshal(b, m, deterministic)
+}
+func (m *LeastCommonMultipleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LeastCommonMultipleRequest.Merge(m, src)
+}
+func (m *LeastCommonMultipleRequest) XXX_Size() int {
+	return xxx_messageInfo_LeastCommonMultipleRequest.Size(m)
+}
+func (m *LeastCommonMultipleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LeastCommonMultipleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LeastCommonMultipleRequest proto.InternalMessageInfo
+
+func (m *LeastCommonMultipleRequest) GetNumbers() []int64 {
+	if m != nil {
+		return m.Numbers
+	}
+	return nil
+}
+
+type LeastCommonMultipleResponse struct {
+	Result               int64    `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LeastCommonMultipleResponse) Reset()         { *m = LeastCommonMultipleResponse{} }
+func (m *LeastCommonMultipleResponse) String() string { return proto.CompactTextString(m) }
+func (*LeastCommonMultipleResponse) ProtoMessage()    {}
+func (*LeastCommonMultipleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62affd3053f75dd3, []int{30}
+}
+
+func (m *LeastCommonMultipleResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LeastCommonMultipleResponse.Unmarshal(m, b)
+}
+func (m *LeastCommonMultipleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LeastCommonMultipleResponse.Marshal(b, m, deterministic)
+}
+func (m *LeastCommonMultipleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LeastCommonMultipleResponse.Merge(m, src)
+}
+func (m *LeastCommonMultipleResponse) XXX_Size() int {
+	return xxx_messageInfo_LeastCommonMultipleResponse.Size(m)
+}
+func (m *LeastCommonMultipleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LeastCommonMultipleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LeastCommonMultipleResponse proto.InternalMessageInfo
+
+func (m *LeastCommonMultipleResponse) GetResult() int64 {
+	if m != nil {
+		return m.Result
+	}
+	return 0
+}
+
+type ModularPowerRequest struct {
+	Base                 int64    `protobuf:"varint,1,opt,name=base,proto3" json:"base,omitempty"`
+	Exponent             int64    `protobuf:"varint,2,opt,name=exponent,proto3" json:"exponent,omitempty"`
+	Modulus              int64    `protobuf:"varint,3,opt,name=modulus,proto3" json:"modulus,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ModularPowerRequest) Reset()         { *m = ModularPowerRequest{} }
+func (m *ModularPowerRequest) String() string { return proto.CompactTextString(m) }
+func (*ModularPowerRequest) ProtoMessage()    {}
+func (*ModularPowerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62affd3053f75dd3, []int{31}
+}
+
+func (m *ModularPowerRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ModularPowerRequest.Unmarshal(m, b)
+}
+func (m *ModularPowerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ModularPowerRequest.Marshal(b, m, deterministic)
+}
+func (m *ModularPowerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModularPowerRequest.Merge(m, src)
+}
+func (m *ModularPowerRequest) XXX_Size() int {
+	return xxx_messageInfo_ModularPowerRequest.Size(m)
+}
+func (m *ModularPowerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModularPowerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModularPowerRequest proto.InternalMessageInfo
+
+func (m *ModularPowerRequest) GetBase() int64 {
+	if m != nil {
+		return m.Base
+	}
+	return 0
+}
+
+func (m *ModularPowerRequest) GetExponent() int64 {
+	if m != nil {
+		return m.Exponent
+	}
+	return 0
+}
+
+func (m *ModularPowerRequest) GetModulus() int64 {
+	if m != nil {
+		return m.Modulus
+	}
+	return 0
+}
+
+type ModularPowerResponse struct {
+	Result               int64    `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ModularPowerResponse) Reset()         { *m = ModularPowerResponse{} }
+func (m *ModularPowerResponse) String() string { return proto.CompactTextString(m) }
+func (*ModularPowerResponse) ProtoMessage()    {}
+func (*ModularPowerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62affd3053f75dd3, []int{32}
+}
+
+func (m *ModularPowerResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ModularPowerResponse.Unmarshal(m, b)
+}
+func (m *ModularPowerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ModularPowerResponse.Marshal(b, m, deterministic)
+}
+func (m *ModularPowerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModularPowerResponse.Merge(m, src)
+}
+func (m *ModularPowerResponse) XXX_Size() int {
+	return xxx_messageInfo_ModularPowerResponse.Size(m)
+}
+func (m *ModularPowerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModularPowerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModularPowerResponse proto.InternalMessageInfo
+
+func (m *ModularPowerResponse) GetResult() int64 {
+	if m != nil {
+		return m.Result
+	}
+	return 0
+}
+
+type ModularInverseRequest struct {
+	Number               int64    `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	Modulus              int64    `protobuf:"varint,2,opt,name=modulus,proto3" json:"modulus,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ModularInverseRequest) Reset()         { *m = ModularInverseRequest{} }
+func (m *ModularInverseRequest) String() string { return proto.CompactTextString(m) }
+func (*ModularInverseRequest) ProtoMessage()    {}
+func (*ModularInverseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62affd3053f75dd3, []int{33}
+}
+
+func (m *ModularInverseRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ModularInverseRequest.Unmarshal(m, b)
+}
+func (m *ModularInverseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ModularInverseRequest.Marshal(b, m, deterministic)
+}
+func (m *ModularInverseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModularInverseRequest.Merge(m, src)
+}
+func (m *ModularInverseRequest) XXX_Size() int {
+	return xxx_messageInfo_ModularInverseRequest.Size(m)
+}
+func (m *ModularInverseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModularInverseRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModularInverseRequest proto.InternalMessageInfo
+
+func (m *ModularInverseRequest) GetNumber() int64 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+func (m *ModularInverseRequest) GetModulus() int64 {
+	if m != nil {
+		return m.Modulus
+	}
+	return 0
+}
+
+type ModularInverseResponse struct {
+	Result               int64    `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ModularInverseResponse) Reset()         { *m = ModularInverseResponse{} }
+func (m *ModularInverseResponse) String() string { return proto.CompactTextString(m) }
+func (*ModularInverseResponse) ProtoMessage()    {}
+func (*ModularInverseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62affd3053f75dd3, []int{34}
+}
+
+func (m *ModularInverseResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ModularInverseResponse.Unmarshal(m, b)
+}
+func (m *ModularInverseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ModularInverseResponse.Marshal(b, m, deterministic)
+}
+func (m *ModularInverseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModularInverseResponse.Merge(m, src)
+}
+func (m *ModularInverseResponse) XXX_Size() int {
+	return xxx_messageInfo_ModularInverseResponse.Size(m)
+}
+func (m *ModularInverseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModularInverseResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModularInverseResponse proto.InternalMessageInfo
+
+func (m *ModularInverseResponse) GetResult() int64 {
+	if m != nil {
+		return m.Result
+	}
+	return 0
+}
+
+type TotientRequest struct {
+	Number               int64    `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TotientRequest) Reset()         { *m = TotientRequest{} }
+func (m *TotientRequest) String() string { return proto.CompactTextString(m) }
+func (*TotientRequest) ProtoMessage()    {}
+func (*TotientRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62affd3053f75dd3, []int{35}
+}
+
+func (m *TotientRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TotientRequest.Unmarshal(m, b)
+}
+func (m *TotientRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TotientRequest.Marshal(b, m, deterministic)
+}
+func (m *TotientRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TotientRequest.Merge(m, src)
+}
+func (m *TotientRequest) XXX_Size() int {
+	return xxx_messageInfo_TotientRequest.Size(m)
+}
+func (m *TotientRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TotientRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TotientRequest proto.InternalMessageInfo
+
+func (m *TotientRequest) GetNumber() int64 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+type TotientResponse struct {
+	Result               int64    `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TotientResponse) Reset()         { *m = TotientResponse{} }
+func (m *TotientResponse) String() string { return proto.CompactTextString(m) }
+func (*TotientResponse) ProtoMessage()    {}
+func (*TotientResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62affd3053f75dd3, []int{36}
+}
+
+func (m *TotientResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TotientResponse.Unmarshal(m, b)
+}
+func (m *TotientResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TotientResponse.Marshal(b, m, deterministic)
+}
+func (m *TotientResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TotientResponse.Merge(m, src)
+}
+func (m *TotientResponse) XXX_Size() int {
+	return xxx_messageInfo_TotientResponse.Size(m)
+}
+func (m *TotientResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TotientResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TotientResponse proto.InternalMessageInfo
+
+func (m *TotientResponse) GetResult() int64 {
+	if m != nil {
+		return m.Result
+	}
+	return 0
+}
+
+type SquareRootRequest struct {
+	Number int64 `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	// The register of the session holding number, instead of number.
+	NumberRegister       string   `protobuf:"bytes,2,opt,name=number_register,json=numberRegister,proto3" json:"number_register,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SquareRootRequest) Reset()         { *m = SquareRootRequest{} }
+func (m *SquareRootRequest) String() string { return proto.CompactTextString(m) }
+func (*SquareRootRequest) ProtoMessage()    {}
+func (*SquareRootRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62affd3053f75dd3, []int{37}
+}
+
+func (m *SquareRootRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SquareRootRequest.Unmarshal(m, b)
+}
+func (m *SquareRootRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SquareRootRequest.Marshal(b, m, deterministic)
+}
+func (m *SquareRootRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SquareRootRequest.Merge(m, src)
+}
+func (m *SquareRootRequest) XXX_Size() int {
+	return xxx_messageInfo_SquareRootRequest.Size(m)
+}
+func (m *SquareRootRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SquareRootRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SquareRootRequest proto.InternalMessageInfo
+
+func (m *SquareRootRequest) GetNumber() int64 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+func (m *SquareRootRequest) GetNumberRegister() string {
+	if m != nil {
+		return m.NumberRegister
+	}
+	return ""
+}
+
+type SquareRootResponse struct {
+	Result               float64  `protobuf:"fixed64,1,opt,name=result,proto3" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SquareRootResponse) Reset()         { *m = SquareRootResponse{} }
+func (m *SquareRootResponse) String() string { return proto.CompactTextString(m) }
+func (*SquareRootResponse) ProtoMessage()    {}
+func (*SquareRootResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62affd3053f75dd3, []int{38}
+}
+
+func (m *SquareRootResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SquareRootResponse.Unmarshal(m, b)
+}
+func (m *SquareRootResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SquareRootResponse.Marshal(b, m, deterministic)
+}
+func (m *SquareRootResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SquareRootResponse.Merge(m, src)
+}
+func (m *SquareRootResponse) XXX_Size() int {
+	return xxx_messageInfo_SquareRootResponse.Size(m)
+}
+func (m *SquareRootResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SquareRootResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SquareRootResponse proto.InternalMessageInfo
+
+func (m *SquareRootResponse) GetResult() float64 {
+	if m != nil {
+		return m.Result
+	}
+	return 0
+}
+
+type RootRequest struct {
+	Number float64 `protobuf:"fixed64,1,opt,name=number,proto3" json:"number,omitempty"`
+	// The register of the session holding number, instead of number.
+	NumberRegister string `protobuf:"bytes,5,opt,name=number_register,json=numberRegister,proto3" json:"number_register,omitempty"`
+	// Degree of the root, 2 when unset.
+	Degree uint32 `protobuf:"varint,2,opt,name=degree,proto3" json:"degree,omitempty"`
+	// Return the complex root of negative numbers for even degrees instead of
+	// an error.
+	Complex bool `protobuf:"varint,3,opt,name=complex,proto3" json:"complex,omitempty"`
+	// Significant digits of the result, at most 17, all of them when unset.
+	SignificantDigits    uint32   `protobuf:"varint,4,opt,name=significant_digits,json=significantDigits,proto3" json:"significant_digits,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RootRequest) Reset()         { *m = RootRequest{} }
+func (m *RootRequest) String() string { return proto.CompactTextString(m) }
+func (*RootRequest) ProtoMessage()    {}
+func (*RootRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62affd3053f75dd3, []int{39}
+}
+
+func (m *RootRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RootRequest.Unmarshal(m, b)
+}
+func (m *RootRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RootRequest.Marshal(b, m, deterministic)
+}
+func (m *RootRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RootRequest.Merge(m, src)
+}
+func (m *RootRequest) XXX_Size() int {
+	return xxx_messageInfo_RootRequest.Size(m)
+}
+func (m *RootRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RootRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RootRequest proto.InternalMessageInfo
+
+func (m *RootRequest) GetNumber() float64 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+func (m *RootRequest) GetNumberRegister() string {
+	if m != nil {
+		return m.NumberRegister
+	}
+	return ""
+}
+
+func (m *RootRequest) GetDegree() uint32 {
+	if m != nil {
+		return m.Degree
+	}
+	return 0
+}
+
+func (m *RootRequest) GetComplex() bool {
+	if m != nil {
+		return m.Complex
+	}
+	return false
+}
+
+func (m *RootRequest) GetSignificantDigits() uint32 {
+	if m != nil {
+		return m.SignificantDigits
+	}
+	return 0
+}
+
+type RootResponse struct {
+	Real                 float64  `protobuf:"fixed64,1,opt,name=real,proto3" json:"real,omitempty"`
+	Imaginary            float64  `protobuf:"fixed64,2,opt,name=imaginary,proto3" json:"imaginary,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RootResponse) Reset()         { *m = RootResponse{} }
+func (m *RootResponse) String() string { return proto.CompactTextString(m) }
+func (*RootResponse) ProtoMessage()    {}
+func (*RootResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62affd3053f75dd3, []int{40}
+}
+
+func (m *RootResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RootResponse.Unmarshal(m, b)
+}
+func (m *RootResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RootResponse.Marshal(b, m, deterministic)
+}
+func (m *RootResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RootResponse.Merge(m, src)
+}
+func (m *RootResponse) XXX_Size() int {
+	return xxx_messageInfo_RootResponse.Size(m)
+}
+func (m *RootResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RootResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RootResponse proto.InternalMessageInfo
+
+func (m *RootResponse) GetReal() float64 {
+	if m != nil {
+		return m.Real
+	}
+	return 0
+}
+
+func (m *RootResponse) GetImaginary() float64 {
+	if m != nil {
+		return m.Imaginary
+	}
+	return 0
+}
+
+type EvaluateRequest struct {
+	Expression           string             `protobuf:"bytes,1,opt,name=expression,proto3" json:"expression,omitempty"`
+	Variables            map[string]float64 `protobuf:"bytes,2,rep,name=variables,proto3" json:"variables,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *EvaluateRequest) Reset()         { *m = EvaluateRequest{} }
+func (m *EvaluateRequest) String() string { return proto.CompactTextString(m) }
+func (*EvaluateRequest) ProtoMessage()    {}
+func (*EvaluateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62affd3053f75dd3, []int{41}
+}
+
+func (m *EvaluateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EvaluateRequest.Unmarshal(m, b)
+}
+func (m *EvaluateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EvaluateRequest.Marshal(b, m, deterministic)
+}
+func (m *EvaluateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvaluateRequest.Merge(m, src)
+}
+func (m *EvaluateRequest) XXX_Size() int {
+	return xxx_messageInfo_EvaluateRequest.Size(m)
+}
+func (m *EvaluateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvaluateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvaluateRequest proto.InternalMessageInfo
+
+func (m *EvaluateRequest) GetExpression() string {
+	if m != nil {
+		return m.Expression
+	}
+	return ""
+}
+
+func (m *EvaluateRequest) GetVariables() map[string]float64 {
+	if m != nil {
+		return m.Variables
+	}
+	return nil
+}
+
+type EvaluateResponse struct {
+	Result               float64  `protobuf:"fixed64,1,opt,name=result,proto3" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EvaluateResponse) Reset()         { *m = EvaluateResponse{} }
+func (m *EvaluateResponse) String() string { return proto.CompactTextString(m) }
+func (*EvaluateResponse) ProtoMessage()    {}
+func (*EvaluateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62affd3053f75dd3, []int{42}
+}
+
+func (m *EvaluateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EvaluateResponse.Unmarshal(m, b)
+}
+func (m *EvaluateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EvaluateResponse.Marshal(b, m, deterministic)
+}
+func (m *EvaluateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvaluateResponse.Merge(m, src)
+}
+func (m *EvaluateResponse) XXX_Size() int {
+	return xxx_messageInfo_EvaluateResponse.Size(m)
+}
+func (m *EvaluateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvaluateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvaluateResponse proto.InternalMessageInfo
+
+func (m *EvaluateResponse) GetResult() float64 {
+	if m != nil {
+		return m.Result
+	}
+	return 0
+}
+
+type BatchOperation struct {
+	// Types that are valid to be assigned to Operation:
+	//	*BatchOperation_Sum
+	//	*BatchOperation_Subtract
+	//	*BatchOperation_Multiply
+	//	*BatchOperation_Divide
+	//	*BatchOperation_Modulo
+	//	*BatchOperation_IntegerPower
+	//	*BatchOperation_SquareRoot
+	//	*BatchOperation_Root
+	//	*BatchOperation_PrimeDecompose
+	//	*BatchOperation_Evaluate
+	Operation            isBatchOperation_Operation `protobuf_oneof:"operation"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *BatchOperation) Reset()         { *m = BatchOperation{} }
+func (m *BatchOperation) String() string { return proto.CompactTextString(m) }
+func (*BatchOperation) ProtoMessage()    {}
+func (*BatchOperation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62affd3053f75dd3, []int{43}
+}
+
+func (m *BatchOperation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchOperation.Unmarshal(m, b)
+}
+func (m *BatchOperation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchOperation.Marshal(b, m, deterministic)
+}
+func (m *BatchOperation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchOperation.Merge(m, src)
+}
+func (m *BatchOperation) XXX_Size() int {
+	return xxx_messageInfo_BatchOperation.Size(m)
+}
+func (m *BatchOperation) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchOperation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchOperation proto.InternalMessageInfo
+
+type isBatchOperation_Operation interface {
+	isBatchOperation_Operation()
+}
+
+type BatchOperation_Sum struct {
+	Sum *CalculateSumRequest `protobuf:"bytes,1,opt,name=sum,proto3,oneof"`
+}
+
+type BatchOperation_Subtract struct {
+	Subtract *SubtractRequest `protobuf:"bytes,2,opt,name=subtract,proto3,oneof"`
+}
+
+type BatchOperation_Multiply struct {
+	Multiply *MultiplyRequest `protobuf:"bytes,3,opt,name=multiply,proto3,oneof"`
+}
+
+type BatchOperation_Divide struct {
+	Divide *DivideRequest `protobuf:"bytes,4,opt,name=divide,proto3,oneof"`
+}
+
+type BatchOperation_Modulo struct {
+	Modulo *ModuloRequest `protobuf:"bytes,5,opt,name=modulo,proto3,oneof"`
+}
+
+type BatchOperation_IntegerPower struct {
+	IntegerPower *IntegerPowerRequest `protobuf:"bytes,6,opt,name=integer_power,json=integerPower,proto3,oneof"`
+}
+
+type BatchOperation_SquareRoot struct {
+	SquareRoot *SquareRootRequest `protobuf:"bytes,7,opt,name=square_root,json=squareRoot,proto3,oneof"`
+}
+
+type BatchOperation_Root struct {
+	Root *RootRequest `protobuf:"bytes,8,opt,name=root,proto3,oneof"`
+}
+
+type BatchOperation_PrimeDecompose struct {
+	PrimeDecompose *PrimeDecomposeRequest `protobuf:"bytes,9,opt,name=prime_decompose,json=primeDecompose,proto3,oneof"`
+}
+
+type BatchOperation_Evaluate struct {
+	Evaluate *EvaluateRequest `protobuf:"bytes,10,opt,name=evaluate,proto3,oneof"`
+}
+
+func (*BatchOperation_Sum) isBatchOperation_Operation() {}
+
+func (*BatchOperation_Subtract) isBatchOperation_Operation() {}
+
+func (*BatchOperation_Multiply) isBatchOperation_Operation() {}
+
+func (*BatchOperation_Divide) isBatchOperation_Operation() {}
+
+func (*BatchOperation_Modulo) isBatchOperation_Operation() {}
+
+func (*BatchOperation_IntegerPower) isBatchOperation_Operation() {}
+
+func (*BatchOperation_SquareRoot) isBatchOperation_Operation() {}
+
+func (*BatchOperation_Root) isBatchOperation_Operation() {}
+
+func (*BatchOperation_PrimeDecompose) isBatchOperation_Operation() {}
+
+func (*BatchOperation_Evaluate) isBatchOperation_Operation() {}
+
+func (m *BatchOperation) GetOperation() isBatchOperation_Operation {
+	if m != nil {
+		return m.Operation
+	}
+	return nil
+}
+
+func (m *BatchOperation) GetSum() *CalculateSumRequest {
+	if x, ok := m.GetOperation().(*BatchOperation_Sum); ok {
+		return x.Sum
+	}
+	return nil
+}
+
+func (m *BatchOperation) GetSubtract() *SubtractRequest {
+	if x, ok := m.GetOperation().(*BatchOperation_Subtract); ok {
+		return x.Subtract
+	}
+	return nil
+}
+
+func (m *BatchOperation) GetMultiply() *MultiplyRequest {
+	if x, ok := m.GetOperation().(*BatchOperation_Multiply); ok {
+		return x.Multiply
+	}
+	return nil
+}
+
+func (m *BatchOperation) GetDivide() *DivideRequest {
+	if x, ok := m.GetOperation().(*BatchOperation_Divide); ok {
+		return x.Divide
+	}
+	return nil
+}
+
+func (m *BatchOperation) GetModulo() *ModuloRequest {
+	if x, ok := m.GetOperation().(*BatchOperation_Modulo); ok {
+		return x.Modulo
+	}
+	return nil
+}
+
+func (m *BatchOperation) GetIntegerPower() *IntegerPowerRequest {
+	if x, ok := m.GetOperation().(*BatchOperation_IntegerPower); ok {
+		return x.IntegerPower
+	}
+	return nil
+}
+
+func (m *BatchOperation) GetSquareRoot() *SquareRootRequest {
+	if x, ok := m.GetOperation().(*BatchOperation_SquareRoot); ok {
+		return x.SquareRoot
+	}
+	return nil
+}
+
+func (m *BatchOperation) GetRoot() *RootRequest {
+	if x, ok := m.GetOperation().(*BatchOperation_Root); ok {
+		return x.Root
+	}
+	return nil
+}
+
+func (m *BatchOperation) GetPrimeDecompose() *PrimeDecomposeRequest {
+	if x, ok := m.GetOperation().(*BatchOperation_PrimeDecompose); ok {
+		return x.PrimeDecompose
+	}
+	return nil
+}
+
+func (m *BatchOperation) GetEvaluate() *EvaluateRequest {
+	if x, ok := m.GetOperation().(*BatchOperation_Evaluate); ok {
+		return x.Evaluate
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*BatchOperation) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*BatchOperation_Sum)(nil),
+		(*BatchOperation_Subtract)(nil),
+		(*BatchOperation_Multiply)(nil),
+		(*BatchOperation_Divide)(nil),
+		(*BatchOperation_Modulo)(nil),
+		(*BatchOperation_IntegerPower)(nil),
+		(*BatchOperation_SquareRoot)(nil),
+		(*BatchOperation_Root)(nil),
+		(*BatchOperation_PrimeDecompose)(nil),
+		(*BatchOperation_Evaluate)(nil),
+	}
+}
+
+type BatchCalculateRequest struct {
+	// At most 1000 operations. Each is validated on its own, an invalid one
+	// only fails its result.
+	Operations           []*BatchOperation `protobuf:"bytes,1,rep,name=operations,proto3" json:"operations,omitempty"`
+	FailFast             bool              `protobuf:"varint,2,opt,name=fail_fast,json=failFast,proto3" json:"fail_fast,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *BatchCalculateRequest) Reset()         { *m = BatchCalculateRequest{} }
+func (m *BatchCalculateRequest) String() string { return proto.CompactTextString(m) }
+func (*BatchCalculateRequest) ProtoMessage()    {}
+func (*BatchCalculateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62affd3053f75dd3, []int{44}
+}
+
+func (m *BatchCalculateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchCalculateRequest.Unmarshal(m, b)
+}
+func (m *BatchCalculateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchCalculateRequest.Marshal(b, m, deterministic)
+}
+func (m *BatchCalculateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchCalculateRequest.Merge(m, src)
+}
+func (m *BatchCalculateRequest) XXX_Size() int {
+	return xxx_messageInfo_BatchCalculateRequest.Size(m)
+}
+func (m *BatchCalculateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchCalculateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchCalculateRequest proto.InternalMessageInfo
+
+func (m *BatchCalculateRequest) GetOperations() []*BatchOperation {
+	if m != nil {
+		return m.Operations
+	}
+	return nil
+}
+
+func (m *BatchCalculateRequest) GetFailFast() bool {
+	if m != nil {
+		return m.FailFast
+	}
+	return false
+}
+
+type PrimeFactors struct {
+	Numbers              []int64  `protobuf:"varint,1,rep,packed,name=numbers,proto3" json:"numbers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PrimeFactors) Reset()         { *m = PrimeFactors{} }
+func (m *PrimeFactors) String() string { return proto.CompactTextString(m) }
+func (*PrimeFactors) ProtoMessage()    {}
+func (*PrimeFactors) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62affd3053f75dd3, []int{45}
+}
+
+func (m *PrimeFactors) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PrimeFactors.Unmarshal(m, b)
+}
+func (m *PrimeFactors) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PrimeFactors.Marshal(b, m, deterministic)
+}
+func (m *PrimeFactors) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrimeFactors.Merge(m, src)
+}
+func (m *PrimeFactors) XXX_Size() int {
+	return xxx_messageInfo_PrimeFactors.Size(m)
+}
+func (m *PrimeFactors) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrimeFactors.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrimeFactors proto.InternalMessageInfo
+
+func (m *PrimeFactors) GetNumbers() []int64 {
+	if m != nil {
+		return m.Numbers
+	}
+	return nil
+}
+
+type BatchResult struct {
+	// Types that are valid to be assigned to Result:
+	//	*BatchResult_Sum
+	//	*BatchResult_Subtract
+	//	*BatchResult_Multiply
+	//	*BatchResult_Divide
+	//	*BatchResult_Modulo
+	//	*BatchResult_IntegerPower
+	//	*BatchResult_SquareRoot
+	//	*BatchResult_Root
+	//	*BatchResult_PrimeDecompose
+	//	*BatchResult_Evaluate
+	//	*BatchResult_Error
+	Result               isBatchResult_Result `protobuf_oneof:"result"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *BatchResult) Reset()         { *m = BatchResult{} }
+func (m *BatchResult) String() string { return proto.CompactTextString(m) }
+func (*BatchResult) ProtoMessage()    {}
+func (*BatchResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62affd3053f75dd3, []int{46}
+}
+
+func (m *BatchResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchResult.Unmarshal(m, b)
+}
+func (m *BatchResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchResult.Marshal(b, m, deterministic)
+}
+func (m *BatchResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchResult.Merge(m, src)
+}
+func (m *BatchResult) XXX_Size() int {
+	return xxx_messageInfo_BatchResult.Size(m)
+}
+func (m *BatchResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchResult proto.InternalMessageInfo
+
+type isBatchResult_Result interface {
+	isBatchResult_Result()
+}
+
+type BatchResult_Sum struct {
+	Sum *CalculateSumResponse `protobuf:"bytes,1,opt,name=sum,proto3,oneof"`
+}
+
+type BatchResult_Subtract struct {
+	Subtract *SubtractResponse `protobuf:"bytes,2,opt,name=subtract,proto3,oneof"`
+}
+
+type BatchResult_Multiply struct {
+	Multiply *MultiplyResponse `protobuf:"bytes,3,opt,name=multiply,proto3,oneof"`
+}
+
+type BatchResult_Divide struct {
+	Divide *DivideResponse `protobuf:"bytes,4,opt,name=divide,proto3,oneof"`
+}
+
+type BatchResult_Modulo struct {
+	Modulo *ModuloResponse `protobuf:"bytes,5,opt,name=modulo,proto3,oneof"`
+}
+
+type BatchResult_IntegerPower struct {
+	IntegerPower *IntegerPowerResponse `protobuf:"bytes,6,opt,name=integer_power,json=integerPower,proto3,oneof"`
+}
+
+type BatchResult_SquareRoot struct {
+	SquareRoot *SquareRootResponse `protobuf:"bytes,7,opt,name=square_root,json=squareRoot,proto3,oneof"`
+}
+
+type BatchResult_Root struct {
+	Root *RootResponse `protobuf:"bytes,8,opt,name=root,proto3,oneof"`
+}
+
+type BatchResult_PrimeDecompose struct {
+	PrimeDecompose *PrimeFactors `protobuf:"bytes,9,opt,name=prime_decompose,json=primeDecompose,proto3,oneof"`
+}
+
+type BatchResult_Evaluate struct {
+	Evaluate *EvaluateResponse `protobuf:"bytes,10,opt,name=evaluate,proto3,oneof"`
+}
+
+type BatchResult_Error struct {
+	Error *status.Status `protobuf:"bytes,15,opt,name=error,proto3,oneof"`
+}
+
+func (*BatchResult_Sum) isBatchResult_Result() {}
+
+func (*BatchResult_Subtract) isBatchResult_Result() {}
+
+func (*BatchResult_Multiply) isBatchResult_Result() {}
+
+func (*BatchResult_Divide) isBatchResult_Result() {}
+
+func (*BatchResult_Modulo) isBatchResult_Result() {}
+
+func (*BatchResult_IntegerPower) isBatchResult_Result() {}
+
+func (*BatchResult_SquareRoot) isBatchResult_Result() {}
+
+func (*BatchResult_Root) isBatchResult_Result() {}
+
+func (*BatchResult_PrimeDecompose) isBatchResult_Result() {}
+
+func (*BatchResult_Evaluate) isBatchResult_Result() {}
+
+func (*BatchResult_Error) isBatchResult_Result() {}
+
+func (m *BatchResult) GetResult() isBatchResult_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (m *BatchResult) GetSum() *CalculateSumResponse {
+	if x, ok := m.GetResult().(*BatchResult_Sum); ok {
+		return x.Sum
+	}
+	return nil
+}
+
+func (m *BatchResult) GetSubtract() *SubtractResponse {
+	if x, ok := m.GetResult().(*BatchResult_Subtract); ok {
+		return x.Subtract
+	}
+	return nil
+}
+
+func (m *BatchResult) GetMultiply() *MultiplyResponse {
+	if x, ok := m.GetResult().(*BatchResult_Multiply); ok {
+		return x.Multiply
+	}
+	return nil
+}
+
+func (m *BatchResult) GetDivide() *DivideResponse {
+	if x, ok := m.GetResult().(*BatchResult_Divide); ok {
+		return x.Divide
+	}
+	return nil
+}
+
+func (m *BatchResult) GetModulo() *ModuloResponse {
+	if x, ok := m.GetResult().(*BatchResult_Modulo); ok {
+		return x.Modulo
+	}
+	return nil
+}
+
+func (m *BatchResult) GetIntegerPower() *IntegerPowerResponse {
+	if x, ok := m.GetResult().(*BatchResult_IntegerPower); ok {
+		return x.IntegerPower
+	}
+	return nil
+}
+
+func (m *BatchResult) GetSquareRoot() *SquareRootResponse {
+	if x, ok := m.GetResult().(*BatchResult_SquareRoot); ok {
+		return x.SquareRoot
+	}
+	return nil
+}
+
+func (m *BatchResult) GetRoot() *RootResponse {
+	if x, ok := m.GetResult().(*BatchResult_Root); ok {
+		return x.Root
+	}
+	return nil
+}
+
+func (m *BatchResult) GetPrimeDecompose() *PrimeFactors {
+	if x, ok := m.GetResult().(*BatchResult_PrimeDecompose); ok {
+		return x.PrimeDecompose
+	}
+	return nil
+}
+
+func (m *BatchResult) GetEvaluate() *EvaluateResponse {
+	if x, ok := m.GetResult().(*BatchResult_Evaluate); ok {
+		return x.Evaluate
+	}
+	return nil
+}
+
+func (m *BatchResult) GetError() *status.Status {
+	if x, ok := m.GetResult().(*BatchResult_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*BatchResult) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*BatchResult_Sum)(nil),
+		(*BatchResult_Subtract)(nil),
+		(*BatchResult_Multiply)(nil),
+		(*BatchResult_Divide)(nil),
+		(*BatchResult_Modulo)(nil),
+		(*BatchResult_IntegerPower)(nil),
+		(*BatchResult_SquareRoot)(nil),
+		(*BatchResult_Root)(nil),
+		(*BatchResult_PrimeDecompose)(nil),
+		(*BatchResult_Evaluate)(nil),
+		(*BatchResult_Error)(nil),
+	}
+}
+
+type BatchCalculateResponse struct {
+	Results              []*BatchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *BatchCalculateResponse) Reset()         { *m = BatchCalculateResponse{} }
+func (m *BatchCalculateResponse) String() string { return proto.CompactTextString(m) }
+func (*BatchCalculateResponse) ProtoMessage()    {}
+func (*BatchCalculateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62affd3053f75dd3, []int{47}
+}
+
+func (m *BatchCalculateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchCalculateResponse.Unmarshal(m, b)
+}
+func (m *BatchCalculateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchCalculateResponse.Marshal(b, m, deterministic)
+}
+func (m *BatchCalculateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchCalculateResponse.Merge(m, src)
+}
+func (m *BatchCalculateResponse) XXX_Size() int {
+	return xxx_messageInfo_BatchCalculateResponse.Size(m)
+}
+func (m *BatchCalculateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchCalculateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchCalculateResponse proto.InternalMessageInfo
+
+func (m *BatchCalculateResponse) GetResults() []*BatchResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+type StoreMemoryRequest struct {
+	// Letters, digits and underscores, not starting with a digit, at most 64
+	// of them.
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value                float64  `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StoreMemoryRequest) Reset()         { *m = StoreMemoryRequest{} }
+func (m *StoreMemoryRequest) String() string { return proto.CompactTextString(m) }
+func (*StoreMemoryRequest) ProtoMessage()    {}
+func (*StoreMemoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62affd3053f75dd3, []int{48}
+}
+
+func (m *StoreMemoryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StoreMemoryRequest.Unmarshal(m, b)
+}
+func (m *StoreMemoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StoreMemoryRequest.Marshal(b, m, deterministic)
+}
+func (m *StoreMemoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StoreMemoryRequest.Merge(m, src)
+}
+func (m *StoreMemoryRequest) XXX_Size() int {
+	return xxx_messageInfo_StoreMemoryRequest.Size(m)
+}
+func (m *StoreMemoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StoreMemoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StoreMemoryRequest proto.InternalMessageInfo
+
+func (m *StoreMemoryRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *StoreMemoryRequest) GetValue() float64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+type StoreMemoryResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StoreMemoryResponse) Reset()         { *m = StoreMemoryResponse{} }
+func (m *StoreMemoryResponse) String() string { return proto.CompactTextString(m) }
+func (*StoreMemoryResponse) ProtoMessage()    {}
+func (*StoreMemoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62affd3053f75dd3, []int{49}
+}
+
+func (m *StoreMemoryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StoreMemoryResponse.Unmarshal(m, b)
+}
+func (m *StoreMemoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StoreMemoryResponse.Marshal(b, m, deterministic)
+}
+func (m *StoreMemoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StoreMemoryResponse.Merge(m, src)
+}
+func (m *StoreMemoryResponse) XXX_Size() int {
+	return xxx_messageInfo_StoreMemoryResponse.Size(m)
+}
+func (m *StoreMemoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StoreMemoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StoreMemoryResponse proto.InternalMessageInfo
+
+type RecallMemoryRequest struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RecallMemoryRequest) Reset()         { *m = RecallMemoryRequest{} }
+func (m *RecallMemoryRequest) String() string { return proto.CompactTextString(m) }
+func (*RecallMemoryRequest) ProtoMessage()    {}
+func (*RecallMemoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62affd3053f75dd3, []int{50}
+}
+
+func (m *RecallMemoryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RecallMemoryRequest.Unmarshal(m, b)
+}
+func (m *RecallMemoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RecallMemoryRequest.Marshal(b, m, deterministic)
+}
+func (m *RecallMemoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecallMemoryRequest.Merge(m, src)
+}
+func (m *RecallMemoryRequest) XXX_Size() int {
+	return xxx_messageInfo_RecallMemoryRequest.Size(m)
+}
+func (m *RecallMemoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecallMemoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecallMemoryRequest proto.InternalMessageInfo
+
+func (m *RecallMemoryRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type RecallMemoryResponse struct {
+	Value                float64  `protobuf:"fixed64,1,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RecallMemoryResponse) Reset()         { *m = RecallMemoryResponse{} }
+func (m *RecallMemoryResponse) String() string { return proto.CompactTextString(m) }
+func (*RecallMemoryResponse) ProtoMessage()    {}
+func (*RecallMemoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62affd3053f75dd3, []int{51}
+}
+
+func (m *RecallMemoryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RecallMemoryResponse.Unmarshal(m, b)
+}
+func (m *RecallMemoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RecallMemoryResponse.Marshal(b, m, deterministic)
+}
+func (m *RecallMemoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecallMemoryResponse.Merge(m, src)
+}
+func (m *RecallMemoryResponse) XXX_Size() int {
+	return xxx_messageInfo_RecallMemoryResponse.Size(m)
+}
+func (m *RecallMemoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecallMemoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecallMemoryResponse proto.InternalMessageInfo
+
+func (m *RecallMemoryResponse) GetValue() float64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+type ClearMemoryRequest struct {
+	// The register to clear, all of them when empty.
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ClearMemoryRequest) Reset()         { *m = ClearMemoryRequest{} }
+func (m *ClearMemoryRequest) String() string { return proto.CompactTextString(m) }
+func (*ClearMemoryRequest) ProtoMessage()    {}
+func (*ClearMemoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62affd3053f75dd3, []int{52}
+}
+
+func (m *ClearMemoryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ClearMemoryRequest.Unmarshal(m, b)
+}
+func (m *ClearMemoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ClearMemoryRequest.Marshal(b, m, deterministic)
+}
+func (m *ClearMemoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClearMemoryRequest.Merge(m, src)
+}
+func (m *ClearMemoryRequest) XXX_Size() int {
+	return xxx_messageInfo_ClearMemoryRequest.Size(m)
+}
+func (m *ClearMemoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClearMemoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClearMemoryRequest proto.InternalMessageInfo
+
+func (m *ClearMemoryRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type ClearMemoryResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ClearMemoryResponse) Reset()         { *m = ClearMemoryResponse{} }
+func (m *ClearMemoryResponse) String() string { return proto.CompactTextString(m) }
+func (*ClearMemoryResponse) ProtoMessage()    {}
+func (*ClearMemoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62affd3053f75dd3, []int{53}
+}
+
+func (m *ClearMemoryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ClearMemoryResponse.Unmarshal(m, b)
+}
+func (m *ClearMemoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ClearMemoryResponse.Marshal(b, m, deterministic)
+}
+func (m *ClearMemoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClearMemoryResponse.Merge(m, src)
+}
+func (m *ClearMemoryResponse) XXX_Size() int {
+	return xxx_messageInfo_ClearMemoryResponse.Size(m)
+}
+func (m *ClearMemoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClearMemoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClearMemoryResponse proto.InternalMessageInfo
+
+type ListHistoryRequest struct {
+	// Number of most recent entries to return, all of them when unset.
+	Limit                uint32   `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListHistoryRequest) Reset()         { *m = ListHistoryRequest{} }
+func (m *ListHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*ListHistoryRequest) ProtoMessage()    {}
+func (*ListHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62affd3053f75dd3, []int{54}
+}
+
+func (m *ListHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListHistoryRequest.Unmarshal(m, b)
+}
+func (m *ListHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListHistoryRequest.Marshal(b, m, deterministic)
+}
+func (m *ListHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListHistoryRequest.Merge(m, src)
+}
+func (m *ListHistoryRequest) XXX_Size() int {
+	return xxx_messageInfo_ListHistoryRequest.Size(m)
+}
+func (m *ListHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListHistoryRequest proto.InternalMessageInfo
+
+func (m *ListHistoryRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type HistoryEntry struct {
+	Time   *timestamp.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	Method string               `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	// The request, unless it is larger than 1 KiB.
+	Request *any.Any `protobuf:"bytes,3,opt,name=request,proto3" json:"request,omitempty"`
+	// The response, unless the operation failed with error or it is larger than
+	// 1 KiB.
+	Response *any.Any `protobuf:"bytes,4,opt,name=response,proto3" json:"response,omitempty"`
+	// The error, reduced to its code and the start of its message when larger
+	// than 1 KiB.
+	Error *status.Status `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	// Sizes in bytes of the request and the response, set even when they are
+	// left out.
+	RequestSize          uint32   `protobuf:"varint,6,opt,name=request_size,json=requestSize,proto3" json:"request_size,omitempty"`
+	ResponseSize         uint32   `protobuf:"varint,7,opt,name=response_size,json=responseSize,proto3" json:"response_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HistoryEntry) Reset()         { *m = HistoryEntry{} }
+func (m *HistoryEntry) String() string { return proto.CompactTextString(m) }
+func (*HistoryEntry) ProtoMessage()    {}
+func (*HistoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62affd3053f75dd3, []int{55}
+}
+
+func (m *HistoryEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HistoryEntry.Unmarshal(m, b)
+}
+func (m *HistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HistoryEntry.Marshal(b, m, deterministic)
+}
+func (m *HistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HistoryEntry.Merge(m, src)
+}
+func (m *HistoryEntry) XXX_Size() int {
+	return xxx_messageInfo_HistoryEntry.Size(m)
+}
+func (m *HistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_HistoryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HistoryEntry proto.InternalMessageInfo
+
+func (m *HistoryEntry) GetTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
+func (m *HistoryEntry) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+func (m *HistoryEntry) GetRequest() *any.Any {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+func (m *HistoryEntry) GetResponse() *any.Any {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (m *HistoryEntry) GetError() *status.Status {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+func (m *HistoryEntry) GetRequestSize() uint32 {
+	if m != nil {
+		return m.RequestSize
+	}
+	return 0
+}
+
+func (m *HistoryEntry) GetResponseSize() uint32 {
+	if m != nil {
+		return m.ResponseSize
+	}
+	return 0
+}
+
+type ListHistoryResponse struct {
+	// The entries, oldest first.
+	Entries              []*HistoryEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ListHistoryResponse) Reset()         { *m = ListHistoryResponse{} }
+func (m *ListHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*ListHistoryResponse) ProtoMessage()    {}
+func (*ListHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62affd3053f75dd3, []int{56}
+}
+
+func (m *ListHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListHistoryResponse.Unmarshal(m, b)
+}
+func (m *ListHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListHistoryResponse.Marshal(b, m, deterministic)
+}
+func (m *ListHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListHistoryResponse.Merge(m, src)
+}
+func (m *ListHistoryResponse) XXX_Size() int {
+	return xxx_messageInfo_ListHistoryResponse.Size(m)
+}
+func (m *ListHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListHistoryResponse proto.InternalMessageInfo
+
+func (m *ListHistoryResponse) GetEntries() []*HistoryEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+type BigOptions struct {
+	Kind BigOptions_Kind `protobuf:"varint,1,opt,name=kind,proto3,enum=calc.BigOptions_Kind" json:"kind,omitempty"`
+	// Mantissa bits of FLOAT operands and results, 256 (about 77 decimal
+	// digits) when unset and at most 65536.
+	Precision uint32 `protobuf:"varint,2,opt,name=precision,proto3" json:"precision,omitempty"`
+	// Rounding of FLOAT results.
+	Rounding             BigOptions_RoundingMode `protobuf:"varint,3,opt,name=rounding,proto3,enum=calc.BigOptions_RoundingMode" json:"rounding,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *BigOptions) Reset()         { *m = BigOptions{} }
+func (m *BigOptions) String() string { return proto.CompactTextString(m) }
+func (*BigOptions) ProtoMessage()    {}
+func (*BigOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62affd3053f75dd3, []int{57}
+}
+
+func (m *BigOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BigOptions.Unmarshal(m, b)
+}
+func (m *BigOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BigOptions.Marshal(b, m, deterministic)
+}
+func (m *BigOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BigOptions.Merge(m, src)
+}
+func (m *BigOptions) XXX_Size() int {
+	return xxx_messageInfo_BigOptions.Size(m)
+}
+func (m *BigOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_BigOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BigOptions proto.InternalMessageInfo
+
+func (m *BigOptions) GetKind() BigOptions_Kind {
+	if m != nil {
+		return m.Kind
+	}
+	return BigOptions_INTEGER
+}
+
+func (m *BigOptions) GetPrecision() uint32 {
+	if m != nil {
+		return m.Precision
+	}
+	return 0
+}
+
+func (m *BigOptions) GetRounding() BigOptions_RoundingMode {
+	if m != nil {
+		return m.Rounding
+	}
+	return BigOptions_TO_NEAREST_EVEN
+}
+
+type BigRequest struct {
+	// At most 320 KiB, about the 315653 decimal digits of the largest results
+	// of 2^20 bits, checked before the operands are parsed.
+	X string `protobuf:"bytes,1,opt,name=x,proto3" json:"x,omitempty"`
+	// The register of the session holding x, instead of x.
+	XRegister string `protobuf:"bytes,4,opt,name=x_register,json=xRegister,proto3" json:"x_register,omitempty"`
+	// The second operand, the exponent of BigPower or the degree of BigRoot,
+	// bounded like x.
+	Y string `protobuf:"bytes,2,opt,name=y,proto3" json:"y,omitempty"`
+	// The register of the session holding y, instead of y.
+	YRegister            string      `protobuf:"bytes,5,opt,name=y_register,json=yRegister,proto3" json:"y_register,omitempty"`
+	Options              *BigOptions `protobuf:"bytes,3,opt,name=options,proto3" json:"options,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *BigRequest) Reset()         { *m = BigRequest{} }
+func (m *BigRequest) String() string { return proto.CompactTextString(m) }
+func (*BigRequest) ProtoMessage()    {}
+func (*BigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62affd3053f75dd3, []int{58}
+}
+
+func (m *BigRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BigRequest.Unmarshal(m, b)
+}
+func (m *BigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BigRequest.Marshal(b, m, deterministic)
+}
+func (m *BigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BigRequest.Merge(m, src)
+}
+func (m *BigRequest) XXX_Size() int {
+	return xxx_messageInfo_BigRequest.Size(m)
+}
+func (m *BigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BigRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BigRequest proto.InternalMessageInfo
+
+func (m *BigRequest) GetX() string {
+	if m != nil {
+		return m.X
+	}
+	return ""
+}
+
+func (m *BigRequest) GetXRegister() string {
+	if m != nil {
+		return m.XRegister
+	}
+	return ""
+}
+
+func (m *BigRequest) GetY() string {
+	if m != nil {
+		return m.Y
+	}
+	return ""
+}
+
+func (m *BigRequest) GetYRegister() string {
+	if m != nil {
+		return m.YRegister
+	}
+	return ""
+}
+
+func (m *BigRequest) GetOptions() *BigOptions {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+type BigResponse struct {
+	Result string `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	// Remainder of INTEGER divisions.
+	Remainder string `protobuf:"bytes,2,opt,name=remainder,proto3" json:"remainder,omitempty"`
+	// Whether the result is exact. FLOAT results are exact when the operands
+	// were represented and the result computed without rounding.
+	Exact                bool     `protobuf:"varint,3,opt,name=exact,proto3" json:"exact,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BigResponse) Reset()         { *m = BigResponse{} }
+func (m *BigResponse) String() string { return proto.CompactTextString(m) }
+func (*BigResponse) ProtoMessage()    {}
+func (*BigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62affd3053f75dd3, []int{59}
+}
+
+func (m *BigResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BigResponse.Unmarshal(m, b)
+}
+func (m *BigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BigResponse.Marshal(b, m, deterministic)
+}
+func (m *BigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BigResponse.Merge(m, src)
+}
+func (m *BigResponse) XXX_Size() int {
+	return xxx_messageInfo_BigResponse.Size(m)
+}
+func (m *BigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BigResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BigResponse proto.InternalMessageInfo
+
+func (m *BigResponse) GetResult() string {
+	if m != nil {
+		return m.Result
+	}
+	return ""
+}
+
+func (m *BigResponse) GetRemainder() string {
+	if m != nil {
+		return m.Remainder
+	}
+	return ""
+}
+
+func (m *BigResponse) GetExact() bool {
+	if m != nil {
+		return m.Exact
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterEnum("calc.AggregateOptions_Aggregation", AggregateOptions_Aggregation_name, AggregateOptions_Aggregation_value)
+	proto.RegisterEnum("calc.AggregateOptions_Emission", AggregateOptions_Emission_name, AggregateOptions_Emission_value)
+	proto.RegisterEnum("calc.BigOptions_Kind", BigOptions_Kind_name, BigOptions_Kind_value)
+	proto.RegisterEnum("calc.BigOptions_RoundingMode", BigOptions_RoundingMode_name, BigOptions_RoundingMode_value)
+	proto.RegisterType((*FindMaxRequest)(nil), "calc.FindMaxRequest")
+	proto.RegisterType((*FindMaxResponse)(nil), "calc.FindMaxResponse")
+	proto.RegisterType((*AggregateOptions)(nil), "calc.AggregateOptions")
+	proto.RegisterType((*AggregateRequest)(nil), "calc.AggregateRequest")
+	proto.RegisterType((*AggregateResponse)(nil), "calc.AggregateResponse")
+	proto.RegisterType((*CalculateAverageRequest)(nil), "calc.CalculateAverageRequest")
+	proto.RegisterType((*CalculateAverageResponse)(nil), "calc.CalculateAverageResponse")
+	proto.RegisterType((*CalculateStatisticsRequest)(nil), "calc.CalculateStatisticsRequest")
+	proto.RegisterType((*CalculateStatisticsResponse)(nil), "calc.CalculateStatisticsResponse")
+	proto.RegisterType((*CalculateSumRequest)(nil), "calc.CalculateSumRequest")
+	proto.RegisterType((*CalculateSumResponse)(nil), "calc.CalculateSumResponse")
+	proto.RegisterType((*SubtractRequest)(nil), "calc.SubtractRequest")
+	proto.RegisterType((*SubtractResponse)(nil), "calc.SubtractResponse")
+	proto.RegisterType((*MultiplyRequest)(nil), "calc.MultiplyRequest")
+	proto.RegisterType((*MultiplyResponse)(nil), "calc.MultiplyResponse")
+	proto.RegisterType((*DivideRequest)(nil), "calc.DivideRequest")
+	proto.RegisterType((*DivideResponse)(nil), "calc.DivideResponse")
+	proto.RegisterType((*ModuloRequest)(nil), "calc.ModuloRequest")
+	proto.RegisterType((*ModuloResponse)(nil), "calc.ModuloResponse")
+	proto.RegisterType((*IntegerPowerRequest)(nil), "calc.IntegerPowerRequest")
+	proto.RegisterType((*IntegerPowerResponse)(nil), "calc.IntegerPowerResponse")
+	proto.RegisterType((*PrimeDecomposeRequest)(nil), "calc.PrimeDecomposeRequest")
+	proto.RegisterType((*PrimeDecomposeResponse)(nil), "calc.PrimeDecomposeResponse")
+	proto.RegisterType((*PrimesInRangeRequest)(nil), "calc.PrimesInRangeRequest")
+	proto.RegisterType((*PrimesInRangeResponse)(nil), "calc.PrimesInRangeResponse")
+	proto.RegisterType((*IsPrimeRequest)(nil), "calc.IsPrimeRequest")
+	proto.RegisterType((*IsPrimeResponse)(nil), "calc.IsPrimeResponse")
+	proto.RegisterType((*GreatestCommonDivisorRequest)(nil), "calc.GreatestCommonDivisorRequest")
+	proto.RegisterType((*GreatestCommonDivisorResponse)(nil), "calc.GreatestCommonDivisorResponse")
+	proto.RegisterType((*LeastCommonMultipleRequest)(nil), "calc.LeastCommonMultipleRequest")
+	proto.RegisterType((*LeastCommonMultipleResponse)(nil), "calc.LeastCommonMultipleResponse")
+	proto.RegisterType((*ModularPowerRequest)(nil), "calc.ModularPowerRequest")
+	proto.RegisterType((*ModularPowerResponse)(nil), "calc.ModularPowerResponse")
+	proto.RegisterType((*ModularInverseRequest)(nil), "calc.ModularInverseRequest")
+	proto.RegisterType((*ModularInverseResponse)(nil), "calc.ModularInverseResponse")
+	proto.RegisterType((*TotientRequest)(nil), "calc.TotientRequest")
+	proto.RegisterType((*TotientResponse)(nil), "calc.TotientResponse")
+	proto.RegisterType((*SquareRootRequest)(nil), "calc.SquareRootRequest")
+	proto.RegisterType((*SquareRootResponse)(nil), "calc.SquareRootResponse")
+	proto.RegisterType((*RootRequest)(nil), "calc.RootRequest")
+	proto.RegisterType((*RootResponse)(nil), "calc.RootResponse")
+	proto.RegisterType((*EvaluateRequest)(nil), "calc.EvaluateRequest")
+	proto.RegisterMapType((map[string]float64)(nil), "calc.EvaluateRequest.VariablesEntry")
+	proto.RegisterType((*EvaluateResponse)(nil), "calc.EvaluateResponse")
+	proto.RegisterType((*BatchOperation)(nil), "calc.BatchOperation")
+	proto.RegisterType((*BatchCalculateRequest)(nil), "calc.BatchCalculateRequest")
+	proto.RegisterType((*PrimeFactors)(nil), "calc.PrimeFactors")
+	proto.RegisterType((*BatchResult)(nil), "calc.BatchResult")
+	proto.RegisterType((*BatchCalculateResponse)(nil), "calc.BatchCalculateResponse")
+	proto.RegisterType((*StoreMemoryRequest)(nil), "calc.StoreMemoryRequest")
+	proto.RegisterType((*StoreMemoryResponse)(nil), "calc.StoreMemoryResponse")
+	proto.RegisterType((*RecallMemoryRequest)(nil), "calc.RecallMemoryRequest")
+	proto.RegisterType((*RecallMemoryResponse)(nil), "calc.RecallMemoryResponse")
+	proto.RegisterType((*ClearMemoryRequest)(nil), "calc.ClearMemoryRequest")
+	proto.RegisterType((*ClearMemoryResponse)(nil), "calc.ClearMemoryResponse")
+	proto.RegisterType((*ListHistoryRequest)(nil), "calc.ListHistoryRequest")
+	proto.RegisterType((*HistoryEntry)(nil), "calc.HistoryEntry")
+	proto.RegisterType((*ListHistoryResponse)(nil), "calc.ListHistoryResponse")
+	proto.RegisterType((*BigOptions)(nil), "calc.BigOptions")
+	proto.RegisterType((*BigRequest)(nil), "calc.BigRequest")
+	proto.RegisterType((*BigResponse)(nil), "calc.BigResponse")
+}
+
+func init() { proto.RegisterFile("calc/calc_proto/calc.proto", fileDescriptor_62affd3053f75dd3) }
+
+var fileDescriptor_62affd3053f75dd3 = []byte{
+	// 3114 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0x4b, 0x73, 0x1b, 0xc7,
+	0x11, 0xe6, 0x02, 0x20, 0x01, 0x34, 0x40, 0x10, 0x1c, 0xf0, 0xb1, 0x5c, 0x51, 0xaf, 0xb5, 0xaa,
+	0x0c, 0x33, 0x12, 0x48, 0xc3, 0xb2, 0x63, 0x29, 0x4e, 0x2c, 0x80, 0x02, 0x45, 0x38, 0x22, 0xa9,
+	0x2c, 0x69, 0x39, 0x92, 0x63, 0x21, 0x4b, 0x60, 0x04, 0x6d, 0x19, 0xd8, 0x85, 0x76, 0x17, 0x34,
+	0xa9, 0x24, 0x55, 0x2a, 0xe7, 0x96, 0x63, 0x92, 0x53, 0x7e, 0x42, 0x8e, 0xa9, 0x4a, 0xe5, 0x90,
+	0xca, 0x0f, 0xc8, 0x39, 0x77, 0x5f, 0x9c, 0x54, 0xe5, 0x07, 0xe4, 0x90, 0xd2, 0x29, 0x35, 0xaf,
+	0x7d, 0x61, 0x01, 0xd0, 0x4a, 0x72, 0xf0, 0x85, 0xdc, 0x99, 0xe9, 0xc7, 0x4c, 0xcf, 0x37, 0x3d,
+	0xdd, 0x3d, 0x00, 0xa5, 0xad, 0xf7, 0xda, 0x9b, 0xe4, 0x4f, 0x6b, 0x60, 0x5b, 0xae, 0x45, 0x3f,
+	0x2b, 0xf4, 0x13, 0xa5, 0xc8, 0xb7, 0xb2, 0xde, 0xb5, 0xac, 0x6e, 0x0f, 0x6f, 0xea, 0x03, 0x63,
+	0x53, 0x37, 0x4d, 0xcb, 0xd5, 0x5d, 0xc3, 0x32, 0x1d, 0x46, 0xa3, 0xac, 0xf1, 0x51, 0xda, 0x3a,
+	0x1e, 0x3e, 0xdd, 0xd4, 0xcd, 0x33, 0x3e, 0x74, 0x29, 0x3a, 0xd4, 0x19, 0xda, 0x94, 0x97, 0x8f,
+	0x5f, 0x8e, 0x8e, 0xbb, 0x46, 0x1f, 0x3b, 0xae, 0xde, 0x1f, 0x70, 0x82, 0x55, 0x4e, 0x60, 0x0f,
+	0xda, 0x9b, 0x8e, 0xab, 0xbb, 0x43, 0xa1, 0x74, 0xf5, 0x44, 0xef, 0x19, 0x1d, 0xdd, 0xc5, 0x9b,
+	0xe2, 0x83, 0x0d, 0xa8, 0x65, 0x28, 0xec, 0x18, 0x66, 0x67, 0x4f, 0x3f, 0xd5, 0xf0, 0xf3, 0x21,
+	0x76, 0x5c, 0xb4, 0x02, 0x73, 0xe6, 0xb0, 0x7f, 0x8c, 0x6d, 0x59, 0xba, 0x22, 0x95, 0x93, 0x1a,
+	0x6f, 0xa9, 0x6f, 0xc1, 0x82, 0x47, 0xe9, 0x0c, 0x2c, 0xd3, 0xc1, 0x63, 0x49, 0xff, 0x9a, 0x84,
+	0x62, 0xad, 0xdb, 0xb5, 0x71, 0x57, 0x77, 0xf1, 0xc1, 0x80, 0xae, 0x1e, 0xed, 0x43, 0x4e, 0xe7,
+	0x7d, 0x86, 0x65, 0x52, 0x8e, 0x42, 0x55, 0xad, 0x50, 0xeb, 0x45, 0x89, 0xbd, 0x0e, 0xc3, 0x32,
+	0xeb, 0x99, 0x57, 0xf5, 0xd9, 0x2f, 0xa5, 0x44, 0x51, 0xd2, 0x82, 0x02, 0xd0, 0x06, 0xe4, 0xbe,
+	0x30, 0xcc, 0x8e, 0xf5, 0x45, 0xcb, 0x31, 0x5e, 0x60, 0x39, 0x71, 0x45, 0x2a, 0xcf, 0xd7, 0xb3,
+	0xaf, 0xea, 0x73, 0x1b, 0x29, 0xf9, 0xe5, 0xcb, 0x94, 0x06, 0x6c, 0xf4, 0xd0, 0x78, 0x81, 0x51,
+	0x1d, 0x16, 0x38, 0xad, 0xb0, 0xa8, 0x9c, 0xbc, 0x22, 0x95, 0x73, 0xd5, 0xb5, 0x0a, 0xb3, 0x58,
+	0x45, 0x98, 0xb4, 0x72, 0x97, 0x13, 0x68, 0x05, 0xc6, 0x21, 0xda, 0xa8, 0x01, 0x19, 0xdc, 0x37,
+	0x1c, 0x87, 0x30, 0xa7, 0xe8, 0xe4, 0x2f, 0x8f, 0x99, 0x7c, 0x83, 0x93, 0x05, 0x66, 0xee, 0xb1,
+	0xa2, 0x1f, 0xc0, 0x3c, 0xee, 0x1b, 0x6e, 0xcb, 0x30, 0x5d, 0x6c, 0x9f, 0xe8, 0x3d, 0x79, 0x76,
+	0xda, 0x44, 0xf2, 0x84, 0xbe, 0xc9, 0xc9, 0xd5, 0x2a, 0xe4, 0x02, 0xc6, 0x41, 0x69, 0x48, 0xee,
+	0xd5, 0x7e, 0x5c, 0x9c, 0xa1, 0x1f, 0xcd, 0xfd, 0xa2, 0x44, 0x3e, 0x0e, 0x3f, 0xde, 0x2b, 0x26,
+	0x50, 0x06, 0x52, 0x7b, 0x8d, 0xda, 0x7e, 0x31, 0xa9, 0xde, 0x86, 0x8c, 0x98, 0x13, 0x9a, 0x87,
+	0xec, 0xc1, 0x7e, 0x6b, 0x7b, 0xb7, 0xb6, 0x7f, 0xaf, 0x51, 0x9c, 0x41, 0x8b, 0x30, 0xdf, 0x78,
+	0xd8, 0xd0, 0x1e, 0xb5, 0xf6, 0x1a, 0x87, 0x87, 0xb5, 0x7b, 0x8d, 0xa2, 0x84, 0xf2, 0x90, 0x79,
+	0xd0, 0xd0, 0x9a, 0x07, 0x77, 0x9b, 0xdb, 0xc5, 0x84, 0xfa, 0x79, 0x60, 0x2b, 0x05, 0x44, 0xaa,
+	0x90, 0xb6, 0xd8, 0x5a, 0xe9, 0x36, 0xe6, 0xaa, 0x2b, 0xf1, 0x96, 0xd8, 0x9d, 0xd1, 0x04, 0x21,
+	0x92, 0x3d, 0xac, 0x90, 0x9d, 0x92, 0x76, 0x67, 0x04, 0x5a, 0xea, 0x59, 0x48, 0xdb, 0x4c, 0xb0,
+	0xfa, 0x21, 0x2c, 0x06, 0x94, 0x71, 0x94, 0x2d, 0xc1, 0xec, 0x89, 0xde, 0x1b, 0x62, 0xaa, 0x4b,
+	0xd2, 0x58, 0x83, 0xf4, 0xb6, 0xad, 0xa1, 0xe9, 0xb2, 0x8d, 0xd7, 0x58, 0x43, 0x7d, 0x1b, 0x56,
+	0xb7, 0xf5, 0x5e, 0x7b, 0xd8, 0xd3, 0x5d, 0x5c, 0x3b, 0xc1, 0xb6, 0xde, 0xc5, 0xf1, 0xb8, 0x96,
+	0x3c, 0xb0, 0xde, 0x04, 0x79, 0x94, 0x85, 0xab, 0x96, 0x21, 0xad, 0xb3, 0x2e, 0xce, 0x24, 0x9a,
+	0xea, 0x4d, 0x50, 0x3c, 0xae, 0x43, 0x72, 0xc0, 0x1d, 0xd7, 0x68, 0x3b, 0xd3, 0x74, 0xfd, 0x36,
+	0x01, 0x17, 0x62, 0xd9, 0xfc, 0xa5, 0xb2, 0x45, 0xb1, 0xf3, 0xc4, 0x1a, 0x08, 0x41, 0xaa, 0x8f,
+	0x75, 0x93, 0x19, 0x4e, 0xa3, 0xdf, 0x48, 0x81, 0xcc, 0x89, 0x6e, 0x1b, 0xba, 0xd9, 0xc6, 0x14,
+	0xca, 0x92, 0xe6, 0xb5, 0xd1, 0x0d, 0x40, 0x8e, 0xab, 0x9b, 0x1d, 0xdd, 0xee, 0xb4, 0x3a, 0xf8,
+	0xc4, 0xd0, 0x5d, 0x81, 0x59, 0x49, 0x5b, 0x14, 0x23, 0x77, 0xc5, 0x00, 0x2a, 0x42, 0xb2, 0x6f,
+	0x98, 0x14, 0x87, 0x92, 0x46, 0x3e, 0x69, 0x8f, 0x7e, 0x2a, 0xcf, 0xf1, 0x1e, 0xfd, 0x94, 0x2c,
+	0xa8, 0x8f, 0x3b, 0x86, 0x6e, 0xca, 0x69, 0xb6, 0x20, 0xd6, 0x22, 0x94, 0x83, 0x5b, 0x5b, 0x72,
+	0x86, 0x51, 0x0e, 0x6e, 0x6d, 0xb1, 0x9e, 0x5b, 0x72, 0x56, 0xf4, 0xdc, 0x42, 0x57, 0x20, 0xa7,
+	0x0f, 0x06, 0xb6, 0x75, 0x6a, 0xf4, 0x75, 0x17, 0xcb, 0x70, 0x45, 0x2a, 0x67, 0xb4, 0x60, 0x97,
+	0xfa, 0x67, 0x09, 0x4a, 0xbe, 0x59, 0x86, 0x7d, 0x61, 0xc6, 0x3c, 0x48, 0xa7, 0xdc, 0x14, 0xd2,
+	0x29, 0xda, 0x06, 0x38, 0x6d, 0xd9, 0xb8, 0x6b, 0x38, 0x2e, 0xb6, 0xe9, 0xa2, 0xb3, 0xf5, 0x6b,
+	0xaf, 0xea, 0x57, 0xed, 0xcb, 0xd5, 0x0b, 0x4f, 0xca, 0x9f, 0xd6, 0x6e, 0x3c, 0xd6, 0x6f, 0xbc,
+	0x68, 0x7d, 0xc6, 0x3f, 0xb6, 0x6e, 0xdc, 0x6a, 0x7d, 0xb6, 0xf1, 0xd6, 0x87, 0xd7, 0xe4, 0x3b,
+	0x5a, 0xf6, 0x54, 0xe3, 0x6c, 0x44, 0xe4, 0x19, 0x35, 0x64, 0x52, 0x93, 0xce, 0x88, 0xc8, 0x33,
+	0x5f, 0x64, 0xea, 0x9b, 0x88, 0x3c, 0x13, 0x22, 0xd5, 0x0a, 0x2c, 0x85, 0x27, 0xef, 0x7b, 0x47,
+	0x1b, 0x3b, 0xc3, 0x9e, 0xd8, 0x4d, 0xde, 0x52, 0xff, 0x24, 0xc1, 0xc2, 0xe1, 0xf0, 0xd8, 0xb5,
+	0xf5, 0xb6, 0xfb, 0xed, 0x5a, 0xe9, 0x06, 0x14, 0xfd, 0x89, 0x9f, 0x63, 0x95, 0x7b, 0xc3, 0x9e,
+	0x6b, 0x0c, 0x7a, 0x67, 0xdf, 0x74, 0x95, 0xf2, 0x9d, 0x89, 0x93, 0xfa, 0xff, 0xaf, 0xd2, 0x9f,
+	0xf8, 0x94, 0x55, 0xfe, 0x51, 0x82, 0xf9, 0xbb, 0xc6, 0x89, 0xd1, 0xc1, 0xdf, 0xae, 0x9d, 0xfc,
+	0x08, 0x0a, 0x62, 0xda, 0x7c, 0x85, 0x0a, 0x64, 0x9e, 0x0f, 0x2d, 0xd7, 0xc0, 0x9e, 0xf7, 0xf1,
+	0xda, 0x68, 0x1d, 0xb2, 0x36, 0xee, 0xeb, 0x86, 0xd9, 0xe1, 0xee, 0x3b, 0xa9, 0xf9, 0x1d, 0xd4,
+	0x06, 0x7b, 0x56, 0x67, 0xd8, 0xb3, 0xbe, 0x5d, 0x36, 0x28, 0x43, 0x41, 0x4c, 0x7b, 0xca, 0x2e,
+	0x7f, 0x2d, 0x41, 0x89, 0x5c, 0xc0, 0x5d, 0x6c, 0x3f, 0xb0, 0xbe, 0xc0, 0xb6, 0x58, 0x27, 0x82,
+	0xd4, 0xb1, 0xee, 0x60, 0x4e, 0x4d, 0xbf, 0x51, 0x13, 0xe6, 0xc9, 0xff, 0xd7, 0x5b, 0x70, 0x9e,
+	0xb0, 0x7a, 0x6b, 0x56, 0x20, 0x83, 0x4f, 0x07, 0x96, 0x89, 0xf9, 0x2d, 0x97, 0xd4, 0xbc, 0x36,
+	0xfa, 0x11, 0x2c, 0x8a, 0xef, 0xd7, 0x33, 0x44, 0x51, 0xb0, 0x07, 0xfd, 0x58, 0x78, 0x91, 0x53,
+	0xac, 0xb2, 0x09, 0xcb, 0x0f, 0x6c, 0xa3, 0x8f, 0xef, 0xe2, 0xb6, 0xd5, 0x1f, 0x58, 0x0e, 0x9e,
+	0x16, 0x41, 0x6e, 0xc1, 0x4a, 0x94, 0x61, 0x4a, 0x20, 0x79, 0x1b, 0x96, 0x28, 0x87, 0xd3, 0x34,
+	0x35, 0xdd, 0xf4, 0xef, 0x72, 0x04, 0xa9, 0xa7, 0xb6, 0xd5, 0x17, 0x86, 0x27, 0xdf, 0xa8, 0x00,
+	0x09, 0xd7, 0xe2, 0x76, 0x4a, 0xb8, 0x96, 0x37, 0x3d, 0x9f, 0x77, 0x8a, 0xb2, 0x32, 0x14, 0x9a,
+	0x0e, 0x65, 0x99, 0xb6, 0x90, 0x37, 0x61, 0xc1, 0xa3, 0xf4, 0x6f, 0xee, 0x01, 0xe9, 0xa0, 0x94,
+	0x19, 0x8d, 0x35, 0xd4, 0xf7, 0x61, 0xfd, 0x9e, 0x8d, 0x75, 0x17, 0x3b, 0xee, 0xb6, 0xd5, 0xef,
+	0x5b, 0x26, 0x39, 0x74, 0x8e, 0xe5, 0x01, 0x48, 0x86, 0x34, 0x13, 0x49, 0x02, 0xa9, 0x64, 0x39,
+	0xa9, 0x89, 0xa6, 0xfa, 0x5d, 0xb8, 0x38, 0x86, 0x73, 0xca, 0xae, 0xbc, 0x07, 0xca, 0x7d, 0xac,
+	0x0b, 0x2e, 0xee, 0xc8, 0xf0, 0x74, 0x85, 0xef, 0xc2, 0x85, 0x58, 0xbe, 0x29, 0xea, 0x5a, 0x50,
+	0xa2, 0x87, 0x48, 0x9f, 0x7e, 0x32, 0x26, 0xc1, 0x59, 0x86, 0x74, 0x9f, 0x88, 0x19, 0x3a, 0xf4,
+	0xbc, 0x24, 0x35, 0xd1, 0x24, 0xa8, 0x0c, 0x2b, 0x98, 0x32, 0xa1, 0x26, 0x2c, 0x73, 0xfa, 0xa6,
+	0x79, 0x82, 0xed, 0xa9, 0xa8, 0x0c, 0xaa, 0x4e, 0x84, 0x55, 0x6f, 0xc1, 0x4a, 0x54, 0xd4, 0x14,
+	0xe5, 0x65, 0x28, 0x1c, 0x31, 0x9f, 0x79, 0x8e, 0x6c, 0xca, 0xa3, 0x9c, 0x22, 0xf4, 0x05, 0x2c,
+	0x1e, 0x3e, 0x1f, 0xea, 0x36, 0xd6, 0x2c, 0x6b, 0x9a, 0x5c, 0xb4, 0x07, 0x0b, 0xec, 0xcb, 0xf7,
+	0x0a, 0x89, 0x6f, 0x70, 0xb3, 0x16, 0x18, 0xb3, 0xe7, 0x13, 0xae, 0x03, 0x0a, 0xea, 0x8e, 0x9d,
+	0xa9, 0xe4, 0xcd, 0xf4, 0x2b, 0x09, 0x72, 0xe3, 0x27, 0x29, 0x4d, 0x9a, 0xe4, 0xec, 0xeb, 0x4f,
+	0x92, 0xa8, 0xe9, 0xe0, 0xae, 0x8d, 0x79, 0x12, 0xa8, 0xf1, 0x16, 0xd9, 0x59, 0xe2, 0x68, 0x7a,
+	0xf8, 0x94, 0x82, 0x2a, 0xa3, 0x89, 0x26, 0x7a, 0x0f, 0x90, 0x63, 0x74, 0x4d, 0xe3, 0xa9, 0xd1,
+	0xd6, 0x4d, 0xb7, 0xd5, 0x31, 0xba, 0x86, 0xeb, 0x50, 0xf7, 0x39, 0x5f, 0x4f, 0xbf, 0xaa, 0xa7,
+	0x36, 0x12, 0xf2, 0xa2, 0xb6, 0x18, 0x20, 0xb9, 0x4b, 0x29, 0xd4, 0x3b, 0x90, 0x0f, 0x19, 0x02,
+	0x41, 0xca, 0xc6, 0x7a, 0x8f, 0x2f, 0x8f, 0x7e, 0x93, 0xcb, 0xd2, 0xe8, 0xeb, 0x5d, 0xc3, 0xd4,
+	0xed, 0x33, 0x1e, 0xb2, 0xfb, 0x1d, 0xea, 0x3f, 0x24, 0x58, 0x68, 0x90, 0x0c, 0x26, 0x90, 0x4e,
+	0x6d, 0x00, 0xe0, 0xd3, 0x81, 0x8d, 0x59, 0x6e, 0x29, 0x51, 0x4b, 0xc0, 0xab, 0x7a, 0xda, 0x9e,
+	0x2d, 0xbf, 0xcc, 0x14, 0x25, 0x2d, 0x30, 0x8a, 0x9e, 0x41, 0x96, 0xc6, 0xf9, 0xc7, 0x3d, 0x4c,
+	0xf0, 0x9a, 0x2c, 0xe7, 0xaa, 0xd7, 0x58, 0xf2, 0x15, 0x91, 0x5a, 0x79, 0x28, 0xc8, 0x1a, 0xa6,
+	0x6b, 0x9f, 0x51, 0xd3, 0xfe, 0x4e, 0xba, 0xa4, 0xae, 0xdb, 0x4a, 0x55, 0x7e, 0x12, 0x6f, 0xdc,
+	0x6b, 0x9a, 0x2f, 0x5c, 0xf9, 0x00, 0x0a, 0x61, 0x11, 0x24, 0xb4, 0xff, 0x1c, 0x9f, 0xb1, 0x09,
+	0x6a, 0xe4, 0xd3, 0x4f, 0xcd, 0x12, 0x81, 0xd4, 0xec, 0x76, 0xe2, 0x7d, 0x89, 0x04, 0x51, 0xfe,
+	0x84, 0xa6, 0xc0, 0xe6, 0x2f, 0x29, 0x28, 0xd4, 0x75, 0xb7, 0xfd, 0xec, 0x60, 0x80, 0x79, 0xb2,
+	0x7d, 0x03, 0x92, 0xce, 0xb0, 0xcf, 0xb3, 0xcb, 0x35, 0xb6, 0xc0, 0x98, 0x0c, 0x61, 0x77, 0x46,
+	0x23, 0x74, 0xe8, 0x1d, 0xc8, 0x38, 0x3c, 0x30, 0xa5, 0x53, 0xc9, 0x55, 0x97, 0x19, 0x4f, 0x24,
+	0xce, 0xde, 0x9d, 0xd1, 0x3c, 0x42, 0xc2, 0xd4, 0xe7, 0x71, 0x9e, 0x9c, 0x0c, 0x32, 0x45, 0xc2,
+	0x56, 0xc2, 0x24, 0x08, 0xd1, 0x0d, 0x98, 0xeb, 0xd0, 0xc0, 0x89, 0xa2, 0x25, 0x57, 0x2d, 0x31,
+	0x96, 0x50, 0x0c, 0x48, 0x72, 0x5b, 0x46, 0x44, 0xc8, 0xa9, 0x37, 0xb1, 0xe4, 0xd9, 0x20, 0x79,
+	0x28, 0x5c, 0x22, 0xe4, 0x8c, 0x08, 0xdd, 0x81, 0x79, 0x83, 0x5d, 0xc1, 0xad, 0x01, 0xf1, 0x76,
+	0xf2, 0x5c, 0xd0, 0x00, 0x31, 0x21, 0xc8, 0xee, 0x8c, 0x96, 0x37, 0x02, 0xdd, 0xe8, 0x36, 0xe4,
+	0x1c, 0x7a, 0x60, 0x5b, 0xb6, 0x65, 0xb9, 0x34, 0x5b, 0xcb, 0x55, 0x57, 0xb9, 0x31, 0xa2, 0x5e,
+	0x64, 0x77, 0x46, 0x03, 0xc7, 0xeb, 0x44, 0x6f, 0x42, 0x8a, 0x32, 0x65, 0x28, 0xd3, 0x22, 0x63,
+	0x0a, 0x93, 0x53, 0x02, 0xb4, 0x03, 0x0b, 0xf4, 0x7e, 0x6b, 0x75, 0xc4, 0x4d, 0x4e, 0xf3, 0xbd,
+	0x5c, 0xf5, 0x02, 0xe3, 0x89, 0x0d, 0x0b, 0x76, 0x67, 0xb4, 0xc2, 0x20, 0x34, 0x40, 0x76, 0x00,
+	0x73, 0x90, 0xc8, 0x10, 0xdc, 0x81, 0x08, 0x96, 0xc9, 0x0e, 0x08, 0xc2, 0x7a, 0x11, 0xb2, 0x96,
+	0x87, 0x93, 0xe4, 0xbf, 0xeb, 0x92, 0xfa, 0x73, 0x58, 0xa6, 0xf0, 0xf1, 0x00, 0x22, 0x0e, 0xd6,
+	0x0e, 0x80, 0x47, 0xca, 0x2e, 0xbc, 0x5c, 0x75, 0x89, 0x69, 0x08, 0xe3, 0xad, 0x5e, 0x7c, 0x55,
+	0x9f, 0xff, 0xb5, 0x04, 0xc5, 0x7f, 0xa6, 0xd5, 0xd9, 0x5f, 0x49, 0x89, 0x8c, 0xa4, 0x05, 0x38,
+	0xd1, 0x05, 0xc8, 0x3e, 0xd5, 0x8d, 0x5e, 0xeb, 0xa9, 0xee, 0x30, 0x7c, 0x65, 0xb4, 0x0c, 0xe9,
+	0xd8, 0xd1, 0x1d, 0xe2, 0xf3, 0xf3, 0x74, 0xbd, 0x3b, 0x7a, 0xdb, 0xb5, 0x6c, 0x67, 0xc2, 0x15,
+	0xfb, 0x55, 0x0a, 0x72, 0x54, 0xaf, 0x46, 0x71, 0x8f, 0x2a, 0x41, 0x90, 0x2b, 0x71, 0x20, 0x67,
+	0x07, 0x47, 0xa0, 0xfc, 0xe6, 0x08, 0xca, 0x57, 0xa2, 0x28, 0xf7, 0x18, 0x7c, 0x98, 0xdf, 0x1c,
+	0x81, 0xf9, 0x4a, 0x14, 0xe6, 0x3e, 0x97, 0x87, 0xf3, 0x4a, 0x04, 0xe7, 0x4b, 0x61, 0x9c, 0x7b,
+	0x1c, 0x02, 0xe8, 0x95, 0x08, 0xd0, 0x97, 0xc2, 0x40, 0xf7, 0xe9, 0x39, 0xd2, 0x6b, 0xf1, 0x48,
+	0x57, 0xe2, 0x90, 0xee, 0x31, 0x87, 0xa1, 0xfe, 0xbd, 0x38, 0xa8, 0xcb, 0xa3, 0x50, 0xf7, 0xd8,
+	0x83, 0x58, 0x2f, 0x87, 0xb0, 0x8e, 0x82, 0x58, 0xf7, 0xe8, 0x19, 0xd8, 0xbf, 0x3f, 0x0e, 0xec,
+	0x28, 0x00, 0x76, 0xbe, 0xf9, 0x31, 0x18, 0xbf, 0x39, 0x82, 0xf1, 0x95, 0x28, 0xc6, 0x7d, 0xf3,
+	0x0b, 0x4a, 0xb4, 0x01, 0xb3, 0xd8, 0xb6, 0x2d, 0x5b, 0x5e, 0xe0, 0xaa, 0x78, 0x75, 0xd0, 0x1e,
+	0xb4, 0x2b, 0x87, 0xb4, 0xb0, 0xbb, 0x3b, 0xa3, 0x31, 0x92, 0x7a, 0x46, 0xb8, 0x55, 0xb5, 0x01,
+	0x2b, 0xd1, 0x83, 0xc0, 0x5d, 0xef, 0x77, 0x20, 0xcd, 0x68, 0xc4, 0x31, 0x58, 0x0c, 0x1c, 0x03,
+	0x06, 0x47, 0x4d, 0x50, 0xa8, 0x3a, 0xa0, 0x43, 0xd7, 0xb2, 0xf1, 0x1e, 0xee, 0x5b, 0xb6, 0x97,
+	0xbc, 0xbf, 0x0b, 0x29, 0x53, 0xe7, 0x01, 0x6e, 0xb6, 0x7e, 0xf5, 0x55, 0xfd, 0x92, 0xbd, 0x3e,
+	0xfe, 0x26, 0x91, 0xef, 0x68, 0x94, 0x3c, 0xfe, 0x8a, 0x50, 0x97, 0xa1, 0x14, 0x52, 0xc1, 0xa6,
+	0xa9, 0xde, 0x87, 0x92, 0x86, 0xdb, 0x7a, 0xaf, 0xf7, 0xbf, 0x50, 0xad, 0x5e, 0x87, 0xa5, 0xb0,
+	0xb4, 0x49, 0x05, 0x45, 0x75, 0x1f, 0xd0, 0x76, 0x0f, 0xeb, 0x76, 0x58, 0xf5, 0xfb, 0x21, 0xd5,
+	0xe7, 0x4b, 0xad, 0x98, 0xf6, 0x65, 0x28, 0x85, 0xe4, 0xf1, 0x25, 0x6e, 0x00, 0xba, 0x6f, 0x38,
+	0xee, 0xae, 0xe1, 0xb8, 0x01, 0x35, 0x4b, 0x30, 0xdb, 0x33, 0xfa, 0x06, 0xbb, 0x19, 0xe7, 0x35,
+	0xd6, 0x50, 0x7f, 0x9f, 0x80, 0x3c, 0x27, 0x64, 0x37, 0x70, 0x05, 0x52, 0xae, 0x48, 0x32, 0xc8,
+	0x61, 0x89, 0xd6, 0x8c, 0x8f, 0xc4, 0x7b, 0x80, 0x46, 0xe9, 0x58, 0xd9, 0xce, 0x7d, 0x66, 0x75,
+	0x58, 0x10, 0xa8, 0xf1, 0x16, 0xaa, 0x78, 0x25, 0x57, 0xee, 0x12, 0x96, 0x46, 0x44, 0xd5, 0xcc,
+	0x33, 0x4d, 0x10, 0xa1, 0x2d, 0xc8, 0xd8, 0x7c, 0x01, 0x72, 0x6a, 0x02, 0x83, 0x47, 0x85, 0xca,
+	0x02, 0xc0, 0xb3, 0xe3, 0x00, 0xcc, 0xe1, 0x8b, 0xae, 0x42, 0x9e, 0xab, 0x61, 0x85, 0xfc, 0x39,
+	0x6a, 0x81, 0x1c, 0xef, 0xa3, 0xe5, 0xfb, 0x37, 0x60, 0x5e, 0x08, 0x66, 0x34, 0x69, 0x4a, 0x93,
+	0x17, 0x9d, 0x84, 0x48, 0xdd, 0x86, 0x52, 0xc8, 0xb0, 0x7c, 0x22, 0xd7, 0x21, 0x8d, 0x4d, 0xd7,
+	0x36, 0xb0, 0x40, 0x3e, 0x3f, 0xb6, 0x41, 0xbb, 0x6a, 0x82, 0x44, 0xfd, 0x3a, 0x01, 0x50, 0x37,
+	0xba, 0xe2, 0xcd, 0xe2, 0x1d, 0x48, 0x7d, 0x6e, 0x98, 0x1d, 0xfe, 0x58, 0xc1, 0x2f, 0x27, 0x7f,
+	0xbc, 0xf2, 0x43, 0xc3, 0xec, 0x04, 0xaa, 0xfc, 0x94, 0x18, 0xbd, 0x09, 0xd9, 0x81, 0x8d, 0xdb,
+	0x06, 0x8d, 0xe6, 0x46, 0x9e, 0x25, 0xfc, 0x31, 0xb4, 0x0d, 0x19, 0xdb, 0x1a, 0x9a, 0x1d, 0xc3,
+	0xec, 0xd2, 0x6d, 0x28, 0x54, 0x2f, 0x8e, 0x68, 0xd0, 0x38, 0xc1, 0x9e, 0xd5, 0xc1, 0xc1, 0xf7,
+	0x04, 0xc1, 0xa8, 0x5e, 0x87, 0x14, 0x99, 0x05, 0xca, 0x41, 0xba, 0xb9, 0x7f, 0xd4, 0xb8, 0xd7,
+	0xd0, 0x8a, 0x33, 0xa4, 0x84, 0xaf, 0xd5, 0x8e, 0x9a, 0x07, 0xfb, 0xb5, 0xfb, 0x45, 0x09, 0x65,
+	0x61, 0x76, 0xe7, 0xfe, 0x41, 0xed, 0xa8, 0x98, 0x50, 0x7f, 0x29, 0x91, 0x08, 0xd6, 0x17, 0x89,
+	0x4a, 0xb0, 0x70, 0x74, 0xd0, 0xda, 0x6f, 0xd4, 0xb4, 0xc6, 0xe1, 0x51, 0xab, 0xf1, 0xb0, 0xb1,
+	0x5f, 0x9c, 0x89, 0x74, 0xd6, 0x3e, 0xa9, 0x3d, 0x2a, 0x4a, 0x44, 0xc1, 0xd1, 0x41, 0xeb, 0x71,
+	0x43, 0x3b, 0x28, 0x26, 0x10, 0x82, 0x02, 0xe9, 0x6e, 0xed, 0x68, 0x07, 0x7b, 0xac, 0x2f, 0xe9,
+	0x71, 0xdd, 0xab, 0x1d, 0x35, 0x1f, 0x36, 0x5a, 0xcd, 0xfd, 0x9d, 0x62, 0x8a, 0x77, 0x3e, 0x38,
+	0x38, 0x6c, 0x7a, 0x9d, 0xb3, 0xea, 0xbf, 0x24, 0x6a, 0x65, 0x01, 0xfe, 0x55, 0x51, 0x2e, 0xca,
+	0x52, 0x43, 0xd9, 0xa9, 0xf2, 0xcb, 0x97, 0x4b, 0xa3, 0x95, 0xa3, 0xd4, 0xeb, 0x55, 0x8e, 0x56,
+	0x45, 0xe5, 0x28, 0x2c, 0x3d, 0x5a, 0x44, 0x9a, 0x7d, 0xad, 0x22, 0x12, 0xda, 0xf0, 0x9f, 0x42,
+	0xd8, 0x49, 0x2a, 0x46, 0xb7, 0xd0, 0x7b, 0x02, 0x51, 0x1f, 0x41, 0x8e, 0xae, 0x3a, 0x36, 0x1c,
+	0xce, 0x8a, 0x70, 0x78, 0xb4, 0xda, 0x96, 0x0d, 0x54, 0xdb, 0x88, 0xa7, 0xc0, 0xa7, 0x24, 0x02,
+	0x60, 0x29, 0x0d, 0x6b, 0x54, 0xff, 0xb0, 0x02, 0x20, 0xbc, 0xbe, 0x65, 0xa3, 0xc7, 0x90, 0x0f,
+	0x06, 0x12, 0x68, 0x7c, 0x04, 0xad, 0x4c, 0x88, 0x3b, 0xd4, 0xd2, 0x97, 0x7f, 0xfb, 0xfb, 0x6f,
+	0x12, 0xf3, 0x6a, 0x66, 0xf3, 0xe4, 0x6d, 0xfa, 0xcc, 0x79, 0x5b, 0xda, 0x40, 0x9f, 0x40, 0x46,
+	0xc4, 0x1b, 0x28, 0x3e, 0xca, 0x56, 0xc6, 0x84, 0x25, 0xea, 0x3a, 0x95, 0xb7, 0xa2, 0x2e, 0x0a,
+	0x79, 0x9b, 0x22, 0x4a, 0xe1, 0x82, 0x45, 0x48, 0x82, 0xe2, 0x23, 0x71, 0x65, 0x4c, 0xe4, 0x12,
+	0x23, 0x58, 0x04, 0x32, 0x44, 0xf0, 0x01, 0xcc, 0xb1, 0xb8, 0x05, 0xc5, 0x45, 0xeb, 0x4a, 0x6c,
+	0x68, 0xa3, 0x2a, 0x54, 0xe4, 0x92, 0xba, 0xe0, 0x89, 0x64, 0x91, 0x0e, 0x17, 0xc8, 0x02, 0x1b,
+	0x14, 0x17, 0xcf, 0x2b, 0xb1, 0xb1, 0x4f, 0x8c, 0x40, 0x16, 0x0a, 0x11, 0x81, 0x3f, 0x85, 0x7c,
+	0x30, 0xe4, 0x41, 0xe3, 0x03, 0x7e, 0x65, 0x42, 0x84, 0xa4, 0xae, 0x51, 0x15, 0x25, 0xb5, 0xe0,
+	0xa9, 0xa0, 0xb1, 0x15, 0x9b, 0x72, 0x21, 0x1c, 0x95, 0xa3, 0x49, 0xb1, 0xba, 0xb2, 0x1e, 0x3f,
+	0xc8, 0xf5, 0xcc, 0x6c, 0x49, 0xe8, 0x3e, 0xcc, 0x87, 0xca, 0x6b, 0x48, 0x09, 0xb0, 0x44, 0xea,
+	0x75, 0xca, 0x85, 0xd8, 0xb1, 0x80, 0xb4, 0x23, 0x48, 0xf3, 0x8a, 0x1a, 0xe2, 0xd6, 0x0b, 0x97,
+	0xe2, 0x94, 0xe5, 0x48, 0xef, 0xd8, 0x8d, 0x37, 0x9c, 0x16, 0x8d, 0xc0, 0xc8, 0xa2, 0xcf, 0x60,
+	0x39, 0xb6, 0x88, 0x86, 0xf8, 0xb3, 0xf3, 0xa4, 0xda, 0x9c, 0xf2, 0xc6, 0x44, 0x1a, 0xae, 0x7f,
+	0x95, 0xea, 0x5f, 0x54, 0xf3, 0x9e, 0xfe, 0x6e, 0xbb, 0x43, 0x54, 0x3b, 0x50, 0x8a, 0x29, 0xa7,
+	0xa1, 0x2b, 0x4c, 0xe8, 0xf8, 0x0a, 0x9d, 0x72, 0x75, 0x02, 0x45, 0x58, 0xe9, 0x6d, 0x69, 0x23,
+	0xa0, 0xb7, 0xd7, 0xee, 0xa3, 0x63, 0xc8, 0x07, 0x6b, 0x65, 0x02, 0x46, 0x31, 0x05, 0x3a, 0x45,
+	0x89, 0x1b, 0xe2, 0xf2, 0x2f, 0x50, 0xf9, 0xcb, 0x44, 0x7e, 0x31, 0x08, 0x56, 0x12, 0xa9, 0xa3,
+	0x1e, 0x14, 0xc2, 0x45, 0x31, 0x01, 0xa4, 0xd8, 0xaa, 0x9b, 0xb2, 0x1e, 0x3f, 0xc8, 0x35, 0x5d,
+	0xa6, 0x9a, 0xd6, 0xd4, 0xa5, 0x90, 0x1a, 0x83, 0x51, 0x11, 0x33, 0x1e, 0x42, 0x9a, 0x97, 0xc9,
+	0x04, 0x2e, 0xc2, 0xf5, 0x35, 0x65, 0x39, 0xd2, 0x3b, 0x69, 0x09, 0x2e, 0x97, 0xf4, 0x31, 0x14,
+	0xa3, 0x2f, 0xbe, 0xe8, 0x62, 0xc4, 0x0d, 0x86, 0x1f, 0x8f, 0x95, 0x4b, 0xe3, 0x86, 0x05, 0x86,
+	0xcb, 0x12, 0x7a, 0x02, 0xa5, 0x98, 0xb7, 0x5d, 0xb1, 0xe5, 0xe3, 0x5f, 0x8b, 0x95, 0xab, 0x13,
+	0x28, 0x02, 0xf2, 0x3f, 0x80, 0x34, 0xff, 0x01, 0x86, 0xb0, 0x45, 0xf8, 0x97, 0x1b, 0xca, 0x72,
+	0xa4, 0xd7, 0xe7, 0xdd, 0x92, 0x50, 0x1d, 0xb2, 0xde, 0xd3, 0x3a, 0x8a, 0xbe, 0xd7, 0x0b, 0x09,
+	0xab, 0x23, 0xfd, 0x21, 0x19, 0x8f, 0x00, 0xfc, 0xc4, 0x0a, 0x8d, 0xab, 0x2a, 0x28, 0x63, 0x73,
+	0x30, 0x55, 0xa6, 0xdb, 0x82, 0xd4, 0x79, 0xff, 0x02, 0x78, 0x6e, 0x53, 0xe7, 0x7f, 0x0f, 0x52,
+	0x54, 0xe8, 0x68, 0xd5, 0x41, 0x89, 0x49, 0xce, 0x62, 0x04, 0x91, 0x5c, 0x8d, 0xdf, 0x22, 0x22,
+	0xb3, 0x42, 0xf1, 0xd5, 0x04, 0x65, 0x4c, 0x02, 0x26, 0x9c, 0x09, 0x01, 0x8d, 0xef, 0x4f, 0xbc,
+	0x94, 0xec, 0x29, 0x2f, 0x52, 0x79, 0xdb, 0x24, 0x80, 0x1f, 0x5b, 0x7b, 0x50, 0xd6, 0xe3, 0x07,
+	0xc7, 0x7a, 0xea, 0x63, 0x42, 0x48, 0x16, 0xf0, 0x04, 0x72, 0x81, 0xd4, 0x08, 0x09, 0x63, 0x8e,
+	0x24, 0x64, 0xca, 0x5a, 0xcc, 0xc8, 0xf8, 0xbb, 0x86, 0x12, 0x10, 0xf9, 0x18, 0xf2, 0xc1, 0xac,
+	0x48, 0x38, 0x89, 0x98, 0xbc, 0x4b, 0x51, 0xe2, 0x86, 0xb8, 0x8a, 0x4b, 0x54, 0x85, 0x8c, 0x56,
+	0x22, 0x2a, 0x36, 0x7f, 0x46, 0xb2, 0x9f, 0x5f, 0xa0, 0x4f, 0x21, 0x17, 0x48, 0x7f, 0xc4, 0x32,
+	0x46, 0x33, 0x2c, 0x65, 0x2d, 0x66, 0x24, 0xec, 0xe8, 0x36, 0xa2, 0xcb, 0x40, 0x3f, 0x81, 0x5c,
+	0x20, 0xd6, 0x17, 0xc2, 0x47, 0xf3, 0x2a, 0x65, 0x2d, 0x66, 0x24, 0x0c, 0x21, 0xe4, 0xfb, 0x87,
+	0x67, 0x5c, 0xdc, 0x47, 0x30, 0x57, 0x37, 0xba, 0xb5, 0x4e, 0x07, 0xf9, 0xc1, 0x9c, 0x10, 0xb8,
+	0x18, 0xe8, 0x09, 0xfb, 0x9a, 0x80, 0xa3, 0x39, 0x36, 0xba, 0x9b, 0x7a, 0x87, 0xde, 0x03, 0x47,
+	0x34, 0xe6, 0xf3, 0x02, 0xa6, 0x73, 0x09, 0xbc, 0x42, 0x05, 0x2a, 0xea, 0x72, 0x48, 0x60, 0x30,
+	0x54, 0x62, 0x52, 0xbd, 0x68, 0xe9, 0xbf, 0x90, 0x1a, 0x8c, 0x93, 0x1e, 0x40, 0xb6, 0x6e, 0x74,
+	0x79, 0xa8, 0x74, 0x2e, 0x99, 0x1c, 0x04, 0x6a, 0x29, 0x24, 0xd3, 0x0f, 0x94, 0xf6, 0x21, 0x53,
+	0x37, 0xba, 0xec, 0x32, 0x3a, 0x97, 0xc0, 0x8b, 0x54, 0xe0, 0x2a, 0x39, 0x82, 0x28, 0x24, 0x93,
+	0x06, 0x32, 0xe8, 0x3e, 0xa4, 0x09, 0x35, 0x71, 0x14, 0xe7, 0x12, 0x37, 0x1a, 0x1e, 0x10, 0x59,
+	0xdc, 0x55, 0xd4, 0x33, 0x8f, 0xe7, 0x48, 0xdf, 0xe0, 0xf8, 0x78, 0x8e, 0x66, 0xb1, 0xef, 0xfc,
+	0x67, 0x00, 0x93, 0x8b, 0x7d, 0xee, 0xdc, 0x27, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// CalculatorClient is the client API for Calculator service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type CalculatorClient interface {
+	// Unary
+	//
+	// error handling
+	// This RPC will throw OUT_OF_RANGE, with an ErrorInfo detail of reason
+	// INTEGER_OVERFLOW, if the sum does not fit in an int64.
+	CalculateSum(ctx context.Context, in *CalculateSumRequest, opts ...grpc.CallOption) (*CalculateSumResponse, error)
+	// Checked integer arithmetic
+	// Subtract, Multiply, Divide, Modulo and IntegerPower compute on int64
+	// like CalculateSum. Divide truncates towards zero and its remainder has
+	// the sign of x, Modulo is always in [0, |y|).
+	//
+	// error handling
+	// These RPCs will throw OUT_OF_RANGE, with an ErrorInfo detail of reason
+	// INTEGER_OVERFLOW, if the result does not fit in an int64, and
+	// INVALID_ARGUMENT on division by zero or for a negative exponent.
+	Subtract(ctx context.Context, in *SubtractRequest, opts ...grpc.CallOption) (*SubtractResponse, error)
+	Multiply(ctx context.Context, in *MultiplyRequest, opts ...grpc.CallOption) (*MultiplyResponse, error)
+	Divide(ctx context.Context, in *DivideRequest, opts ...grpc.CallOption) (*DivideResponse, error)
+	Modulo(ctx context.Context, in *ModuloRequest, opts ...grpc.CallOption) (*ModuloResponse, error)
+	IntegerPower(ctx context.Context, in *IntegerPowerRequest, opts ...grpc.CallOption) (*IntegerPowerResponse, error)
+	// Server stream
+	// Streams the prime factors of a number in increasing order, each repeated
+	// as many times as it divides the number.
+	//
+	// error handling
+	// This RPC will throw INVALID_ARGUMENT if the number is not positive and
+	// RESOURCE_EXHAUSTED if factoring it exceeds the compute budget.
+	PrimeDecompose(ctx context.Context, in *PrimeDecomposeRequest, opts ...grpc.CallOption) (Calculator_PrimeDecomposeClient, error)
+	// Server stream
+	// Streams the primes in [from, to] in increasing order.
+	//
+	// error handling
+	// This RPC will throw INVALID_ARGUMENT if from is greater than to or if the
+	// range holds more than 2^24 numbers.
+	PrimesInRange(ctx context.Context, in *PrimesInRangeRequest, opts ...grpc.CallOption) (Calculator_PrimesInRangeClient, error)
+	// Number theory
+	// IsPrime is deterministic for every int64. GreatestCommonDivisor and
+	// LeastCommonMultiple reduce a list of numbers, by absolute value.
+	// ModularPower and ModularInverse compute modulo a positive modulus, a
+	// negative exponent raising the inverse of the base. Totient counts the
+	// numbers in [1, number] coprime to number.
+	//
+	// error handling
+	// These RPCs will throw INVALID_ARGUMENT for an empty list of numbers, a
+	// non-positive modulus, a number with no inverse modulo the modulus or a
+	// non-positive totient argument, and OUT_OF_RANGE, with an ErrorInfo
+	// detail of reason INTEGER_OVERFLOW, if the result does not fit in an
+	// int64.
+	IsPrime(ctx context.Context, in *IsPrimeRequest, opts ...grpc.CallOption) (*IsPrimeResponse, error)
+	GreatestCommonDivisor(ctx context.Context, in *GreatestCommonDivisorRequest, opts ...grpc.CallOption) (*GreatestCommonDivisorResponse, error)
+	LeastCommonMultiple(ctx context.Context, in *LeastCommonMultipleRequest, opts ...grpc.CallOption) (*LeastCommonMultipleResponse, error)
+	ModularPower(ctx context.Context, in *ModularPowerRequest, opts ...grpc.CallOption) (*ModularPowerResponse, error)
+	ModularInverse(ctx context.Context, in *ModularInverseRequest, opts ...grpc.CallOption) (*ModularInverseResponse, error)
+	Totient(ctx context.Context, in *TotientRequest, opts ...grpc.CallOption) (*TotientResponse, error)
+	// Client stream
+	//
+	// error handling
+	// This RPC will throw INVALID_ARGUMENT if the stream holds no number or a
+	// number that is NaN or infinite, and OUT_OF_RANGE if the average
+	// overflows.
+	CalculateAverage(ctx context.Context, opts ...grpc.CallOption) (Calculator_CalculateAverageClient, error)
+	// Client stream
+	// Describes the numbers of the stream. The median and percentiles are
+	// exact for the first 1024 numbers and estimated past that.
+	//
+	// error handling
+	// This RPC will throw INVALID_ARGUMENT if the stream holds no number or a
+	// number that is NaN or infinite, and OUT_OF_RANGE if the numbers are too
+	// large for the statistics to be finite.
+	CalculateStatistics(ctx context.Context, opts ...grpc.CallOption) (Calculator_CalculateStatisticsClient, error)
+	// Bi-directional stream
+	FindMax(ctx context.Context, opts ...grpc.CallOption) (Calculator_FindMaxClient, error)
+	// Bi-directional stream
+	// Aggregates the numbers of the stream over a sliding window. The first
+	// message holds the options, the following ones the numbers. The responses
+	// are sent as chosen by the emission policy of the options.
+	//
+	// error handling
+	// This RPC will throw INVALID_ARGUMENT if the first message does not hold
+	// valid options, if a later one holds options, or if a number is NaN or
+	// infinite, and RESOURCE_EXHAUSTED if a time window would hold more than
+	// 65536 numbers.
+	Aggregate(ctx context.Context, opts ...grpc.CallOption) (Calculator_AggregateClient, error)
+	// Calculates the square root of a given number.
+	//
+	// error handling
+	// This RPC will throw INVALID_ARGUMENT if the number in the request is
+	// negative.
+	SquareRoot(ctx context.Context, in *SquareRootRequest, opts ...grpc.CallOption) (*SquareRootResponse, error)
+	// Calculates the root of a given degree of a number. Odd-degree roots of
+	// negative numbers are real, even-degree ones are the principal complex
+	// root when complex results are requested.
+	//
+	// error handling
+	// This RPC will throw INVALID_ARGUMENT if the number is NaN or infinite,
+	// if more than 17 significant digits are requested, or if the number is
+	// negative, the degree even and complex results were not requested.
+	Root(ctx context.Context, in *RootRequest, opts ...grpc.CallOption) (*RootResponse, error)
+	// Evaluates an infix expression such as "(x + y) * sqrt(z)" with the
+	// operators + - * / % ^, parentheses, the variables of the request and the
+	// functions sqrt, pow, abs, min, max and log.
+	//
+	// error handling
+	// This RPC will throw INVALID_ARGUMENT, with the position of the error in
+	// the message and a BadRequest detail, if the expression is malformed,
+	// longer than 1024 bytes, uses an undefined variable or does not evaluate
+	// to a finite number.
+	Evaluate(ctx context.Context, in *EvaluateRequest, opts ...grpc.CallOption) (*EvaluateResponse, error)
+	// Runs many operations in one round trip, concurrently. The results are in
+	// the order of the operations, each either the response of the operation
+	// or the status it failed with. With fail_fast, the operations left once
+	// one failed are not run and fail with ABORTED. Each operation needs the
+	// scopes of the RPC it stands for, e.g. calc.factor for prime_decompose,
+	// and fails with PERMISSION_DENIED without them. Under rate limiting each
+	// operation also takes a token of that RPC, and fails with
+	// RESOURCE_EXHAUSTED when none is left.
+	//
+	// error handling
+	// This RPC will throw INVALID_ARGUMENT if the batch holds more than 1000
+	// operations. The failures of single operations do not fail the batch.
+	BatchCalculate(ctx context.Context, in *BatchCalculateRequest, opts ...grpc.CallOption) (*BatchCalculateResponse, error)
+	// Sessions
+	// A session is selected by the x-session-id metadata of the requests, and
+	// created on first use. It keeps named memory registers, which Evaluate
+	// uses as variables, and the history of the unary operations called in
+	// it. Sessions idle for 30 minutes are deleted.
+	//
+	// The operands of the integer arithmetic, root and Big RPCs may name a
+	// register of the session instead of holding a value, e.g. x_register
+	// instead of x, but not both. Integer operands must name registers
+	// holding integers. Such requests are never answered from the response
+	// cache.
+	//
+	// error handling
+	// These RPCs will throw INVALID_ARGUMENT if the session ID is missing or
+	// too long, or if a register name is not an identifier, NOT_FOUND when
+	// recalling an unset register, and RESOURCE_EXHAUSTED if there are too
+	// many sessions, overall or created by the caller, or registers.
+	// Register operands fail the same way, and with INVALID_ARGUMENT when an
+	// operand sets both a value and a register, or when an integer operand
+	// names a register holding a fraction or a number out of the int64 range.
+	StoreMemory(ctx context.Context, in *StoreMemoryRequest, opts ...grpc.CallOption) (*StoreMemoryResponse, error)
+	RecallMemory(ctx context.Context, in *RecallMemoryRequest, opts ...grpc.CallOption) (*RecallMemoryResponse, error)
+	// Clears a register, or all of them when no name is given.
+	ClearMemory(ctx context.Context, in *ClearMemoryRequest, opts ...grpc.CallOption) (*ClearMemoryResponse, error)
+	ListHistory(ctx context.Context, in *ListHistoryRequest, opts ...grpc.CallOption) (*ListHistoryResponse, error)
+	// Arbitrary-precision arithmetic
+	// The Big RPCs take their operands as decimal strings of at most 320 KiB
+	// and compute on integers, exact rationals or floats of the requested
+	// precision.
+	//
+	// error handling
+	// These RPCs will throw INVALID_ARGUMENT if an operand is malformed, on
+	// division by zero or for a root that is not defined, and OUT_OF_RANGE if
+	// the result would be too large to compute.
+	// Adds x and y.
+	BigAdd(ctx context.Context, in *BigRequest, opts ...grpc.CallOption) (*BigResponse, error)
+	// Subtracts y from x.
+	BigSubtract(ctx context.Context, in *BigRequest, opts ...grpc.CallOption) (*BigResponse, error)
+	// Multiplies x by y.
+	BigMultiply(ctx context.Context, in *BigRequest, opts ...grpc.CallOption) (*BigResponse, error)
+	// Divides x by y. INTEGER division truncates towards zero and returns the
+	// remainder.
+	BigDivide(ctx context.Context, in *BigRequest, opts ...grpc.CallOption) (*BigResponse, error)
+	// Raises x to the integer power y.
+	BigPower(ctx context.Context, in *BigRequest, opts ...grpc.CallOption) (*BigResponse, error)
+	// Takes the root of degree y of x. INTEGER roots are truncated towards zero
+	// and RATIONAL roots must be rational.
+	BigRoot(ctx context.Context, in *BigRequest, opts ...grpc.CallOption) (*BigResponse, error)
+}
+
+type calculatorClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewCalculatorClient(cc *grpc.ClientConn) CalculatorClient {
+	return &calculatorClient{cc}
+}
+
+func (c *calculatorClient) CalculateSum(ctx context.Context, in *CalculateSumRequest, opts ...grpc.CallOption) (*CalculateSumResponse, error) {
+	out := new(CalculateSumResponse)
+	err := c.cc.Invoke(ctx, "/calc.Calculator/CalculateSum", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorClient) Subtract(ctx context.Context, in *SubtractRequest, opts ...grpc.CallOption) (*SubtractResponse, error) {
+	out := new(SubtractResponse)
+	err := c.cc.Invoke(ctx, "/calc.Calculator/Subtract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorClient) Multiply(ctx context.Context, in *MultiplyRequest, opts ...grpc.CallOption) (*MultiplyResponse, error) {
+	out := new(MultiplyResponse)
+	err := c.cc.Invoke(ctx, "/calc.Calculator/Multiply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorClient) Divide(ctx context.Context, in *DivideRequest, opts ...grpc.CallOption) (*DivideResponse, error) {
+	out := new(DivideResponse)
+	err := c.cc.Invoke(ctx, "/calc.Calculator/Divide", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorClient) Modulo(ctx context.Context, in *ModuloRequest, opts ...grpc.CallOption) (*ModuloResponse, error) {
+	out := new(ModuloResponse)
+	err := c.cc.Invoke(ctx, "/calc.Calculator/Modulo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorClient) IntegerPower(ctx context.Context, in *IntegerPowerRequest, opts ...grpc.CallOption) (*IntegerPowerResponse, error) {
+	out := new(IntegerPowerResponse)
+	err := c.cc.Invoke(ctx, "/calc.Calculator/IntegerPower", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorClient) PrimeDecompose(ctx context.Context, in *PrimeDecomposeRequest, opts ...grpc.CallOption) (Calculator_PrimeDecomposeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Calculator_serviceDesc.Streams[0], "/calc.Calculator/PrimeDecompose", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &calculatorPrimeDecomposeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Calculator_PrimeDecomposeClient interface {
+	Recv() (*PrimeDecomposeResponse, error)
+	grpc.ClientStream
+}
+
+type calculatorPrimeDecomposeClient struct {
+	grpc.ClientStream
+}
+
+func (x *calculatorPrimeDecomposeClient) Recv() (*PrimeDecomposeResponse, error) {
+	m := new(PrimeDecomposeResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *calculatorClient) PrimesInRange(ctx context.Context, in *PrimesInRangeRequest, opts ...grpc.CallOption) (Calculator_PrimesInRangeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Calculator_serviceDesc.Streams[1], "/calc.Calculator/PrimesInRange", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &calculatorPrimesInRangeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Calculator_PrimesInRangeClient interface {
+	Recv() (*PrimesInRangeResponse, error)
+	grpc.ClientStream
+}
+
+type calculatorPrimesInRangeClient struct {
+	grpc.ClientStream
+}
+
+func (x *calculatorPrimesInRangeClient) Recv() (*PrimesInRangeResponse, error) {
+	m := new(PrimesInRangeResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *calculatorClient) IsPrime(ctx context.Context, in *IsPrimeRequest, opts ...grpc.CallOption) (*IsPrimeResponse, error) {
+	out := new(IsPrimeResponse)
+	err := c.cc.Invoke(ctx, "/calc.Calculator/IsPrime", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorClient) GreatestCommonDivisor(ctx context.Context, in *GreatestCommonDivisorRequest, opts ...grpc.CallOption) (*GreatestCommonDivisorResponse, error) {
+	out := new(GreatestCommonDivisorResponse)
+	err := c.cc.Invoke(ctx, "/calc.Calculator/GreatestCommonDivisor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorClient) LeastCommonMultiple(ctx context.Context, in *LeastCommonMultipleRequest, opts ...grpc.CallOption) (*LeastCommonMultipleResponse, error) {
+	out := new(LeastCommonMultipleResponse)
+	err := c.cc.Invoke(ctx, "/calc.Calculator/LeastCommonMultiple", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorClient) ModularPower(ctx context.Context, in *ModularPowerRequest, opts ...grpc.CallOption) (*ModularPowerResponse, error) {
+	out := new(ModularPowerResponse)
+	err := c.cc.Invoke(ctx, "/calc.Calculator/ModularPower", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorClient) ModularInverse(ctx context.Context, in *ModularInverseRequest, opts ...grpc.CallOption) (*ModularInverseResponse, error) {
+	out := new(ModularInverseResponse)
+	err := c.cc.Invoke(ctx, "/calc.Calculator/ModularInverse", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorClient) Totient(ctx context.Context, in *TotientRequest, opts ...grpc.CallOption) (*TotientResponse, error) {
+	out := new(TotientResponse)
+	err := c.cc.Invoke(ctx, "/calc.Calculator/Totient", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorClient) CalculateAverage(ctx context.Context, opts ...grpc.CallOption) (Calculator_CalculateAverageClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Calculator_serviceDesc.Streams[2], "/calc.Calculator/CalculateAverage", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &calculatorCalculateAverageClient{stream}
+	return x, nil
+}
+
+type Calculator_CalculateAverageClient interface {
+	Send(*CalculateAverageRequest) error
+	CloseAndRecv() (*CalculateAverageResponse, error)
+	grpc.ClientStream
+}
+
+type calculatorCalculateAverageClient struct {
+	grpc.ClientStream
+}
+
+func (x *calculatorCalculateAverageClient) Send(m *CalculateAverageRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *calculatorCalculateAverageClient) CloseAndRecv() (*CalculateAverageResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(CalculateAverageResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *calculatorClient) CalculateStatistics(ctx context.Context, opts ...grpc.CallOption) (Calculator_CalculateStatisticsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Calculator_serviceDesc.Streams[3], "/calc.Calculator/CalculateStatistics", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &calculatorCalculateStatisticsClient{stream}
+	return x, nil
+}
+
+type Calculator_CalculateStatisticsClient interface {
+	Send(*CalculateStatisticsRequest) error
+	CloseAndRecv() (*CalculateStatisticsResponse, error)
+	grpc.ClientStream
+}
+
+type calculatorCalculateStatisticsClient struct {
+	grpc.ClientStream
+}
+
+func (x *calculatorCalculateStatisticsClient) Send(m *CalculateStatisticsRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *calculatorCalculateStatisticsClient) CloseAndRecv() (*CalculateStatisticsResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(CalculateStatisticsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *calculatorClient) FindMax(ctx context.Context, opts ...grpc.CallOption) (Calculator_FindMaxClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Calculator_serviceDesc.Streams[4], "/calc.Calculator/FindMax", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &calculatorFindMaxClient{stream}
+	return x, nil
+}
+
+type Calculator_FindMaxClient interface {
+	Send(*FindMaxRequest) error
+	Recv() (*FindMaxResponse, error)
+	grpc.ClientStream
+}
+
+type calculatorFindMaxClient struct {
+	grpc.ClientStream
+}
+
+func (x *calculatorFindMaxClient) Send(m *FindMaxRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *calculatorFindMaxClient) Recv() (*FindMaxResponse, error) {
+	m := new(FindMaxResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *calculatorClient) Aggregate(ctx context.Context, opts ...grpc.CallOption) (Calculator_AggregateClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Calculator_serviceDesc.Streams[5], "/calc.Calculator/Aggregate", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &calculatorAggregateClient{stream}
+	return x, nil
+}
+
+type Calculator_AggregateClient interface {
+	Send(*AggregateRequest) error
+	Recv() (*AggregateResponse, error)
+	grpc.ClientStream
+}
+
+type calculatorAggregateClient struct {
+	grpc.ClientStream
+}
+
+func (x *calculatorAggregateClient) Send(m *AggregateRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *calculatorAggregateClient) Recv() (*AggregateResponse, error) {
+	m := new(AggregateResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
 	return m, nil
 }
 
-func (c *calculatorClient) SquareRoot(ctx context.Context, in *SquareRootRequest, opts ...grpc.CallOption) (*SquareRootResponse, error) {
-	out := new(SquareRootResponse)
-	err := c.cc.Invoke(ctx, "/calc.Calculator/SquareRoot", in, out, opts...)
+func (c *calculatorClient) SquareRoot(ctx context.Context, in *SquareRootRequest, opts ...grpc.CallOption) (*SquareRootResponse, error) {
+	out := new(SquareRootResponse)
+	err := c.cc.Invoke(ctx, "/calc.Calculator/SquareRoot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorClient) Root(ctx context.Context, in *RootRequest, opts ...grpc.CallOption) (*RootResponse, error) {
+	out := new(RootResponse)
+	err := c.cc.Invoke(ctx, "/calc.Calculator/Root", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorClient) Evaluate(ctx context.Context, in *EvaluateRequest, opts ...grpc.CallOption) (*EvaluateResponse, error) {
+	out := new(EvaluateResponse)
+	err := c.cc.Invoke(ctx, "/calc.Calculator/Evaluate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorClient) BatchCalculate(ctx context.Context, in *BatchCalculateRequest, opts ...grpc.CallOption) (*BatchCalculateResponse, error) {
+	out := new(BatchCalculateResponse)
+	err := c.cc.Invoke(ctx, "/calc.Calculator/BatchCalculate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorClient) StoreMemory(ctx context.Context, in *StoreMemoryRequest, opts ...grpc.CallOption) (*StoreMemoryResponse, error) {
+	out := new(StoreMemoryResponse)
+	err := c.cc.Invoke(ctx, "/calc.Calculator/StoreMemory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorClient) RecallMemory(ctx context.Context, in *RecallMemoryRequest, opts ...grpc.CallOption) (*RecallMemoryResponse, error) {
+	out := new(RecallMemoryResponse)
+	err := c.cc.Invoke(ctx, "/calc.Calculator/RecallMemory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorClient) ClearMemory(ctx context.Context, in *ClearMemoryRequest, opts ...grpc.CallOption) (*ClearMemoryResponse, error) {
+	out := new(ClearMemoryResponse)
+	err := c.cc.Invoke(ctx, "/calc.Calculator/ClearMemory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorClient) ListHistory(ctx context.Context, in *ListHistoryRequest, opts ...grpc.CallOption) (*ListHistoryResponse, error) {
+	out := new(ListHistoryResponse)
+	err := c.cc.Invoke(ctx, "/calc.Calculator/ListHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorClient) BigAdd(ctx context.Context, in *BigRequest, opts ...grpc.CallOption) (*BigResponse, error) {
+	out := new(BigResponse)
+	err := c.cc.Invoke(ctx, "/calc.Calculator/BigAdd", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorClient) BigSubtract(ctx context.Context, in *BigRequest, opts ...grpc.CallOption) (*BigResponse, error) {
+	out := new(BigResponse)
+	err := c.cc.Invoke(ctx, "/calc.Calculator/BigSubtract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorClient) BigMultiply(ctx context.Context, in *BigRequest, opts ...grpc.CallOption) (*BigResponse, error) {
+	out := new(BigResponse)
+	err := c.cc.Invoke(ctx, "/calc.Calculator/BigMultiply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorClient) BigDivide(ctx context.Context, in *BigRequest, opts ...grpc.CallOption) (*BigResponse, error) {
+	out := new(BigResponse)
+	err := c.cc.Invoke(ctx, "/calc.Calculator/BigDivide", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorClient) BigPower(ctx context.Context, in *BigRequest, opts ...grpc.CallOption) (*BigResponse, error) {
+	out := new(BigResponse)
+	err := c.cc.Invoke(ctx, "/calc.Calculator/BigPower", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorClient) BigRoot(ctx context.Context, in *BigRequest, opts ...grpc.CallOption) (*BigResponse, error) {
+	out := new(BigResponse)
+	err := c.cc.Invoke(ctx, "/calc.Calculator/BigRoot", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -622,19 +4288,174 @@ func (c *calculatorClient) SquareRoot(ctx context.Context, in *SquareRootRequest
 // CalculatorServer is the server API for Calculator service.
 type CalculatorServer interface {
 	// Unary
+	//
+	// error handling
+	// This RPC will throw OUT_OF_RANGE, with an ErrorInfo detail of reason
+	// INTEGER_OVERFLOW, if the sum does not fit in an int64.
 	CalculateSum(context.Context, *CalculateSumRequest) (*CalculateSumResponse, error)
+	// Checked integer arithmetic
+	// Subtract, Multiply, Divide, Modulo and IntegerPower compute on int64
+	// like CalculateSum. Divide truncates towards zero and its remainder has
+	// the sign of x, Modulo is always in [0, |y|).
+	//
+	// error handling
+	// These RPCs will throw OUT_OF_RANGE, with an ErrorInfo detail of reason
+	// INTEGER_OVERFLOW, if the result does not fit in an int64, and
+	// INVALID_ARGUMENT on division by zero or for a negative exponent.
+	Subtract(context.Context, *SubtractRequest) (*SubtractResponse, error)
+	Multiply(context.Context, *MultiplyRequest) (*MultiplyResponse, error)
+	Divide(context.Context, *DivideRequest) (*DivideResponse, error)
+	Modulo(context.Context, *ModuloRequest) (*ModuloResponse, error)
+	IntegerPower(context.Context, *IntegerPowerRequest) (*IntegerPowerResponse, error)
 	// Server stream
+	// Streams the prime factors of a number in increasing order, each repeated
+	// as many times as it divides the number.
+	//
+	// error handling
+	// This RPC will throw INVALID_ARGUMENT if the number is not positive and
+	// RESOURCE_EXHAUSTED if factoring it exceeds the compute budget.
 	PrimeDecompose(*PrimeDecomposeRequest, Calculator_PrimeDecomposeServer) error
+	// Server stream
+	// Streams the primes in [from, to] in increasing order.
+	//
+	// error handling
+	// This RPC will throw INVALID_ARGUMENT if from is greater than to or if the
+	// range holds more than 2^24 numbers.
+	PrimesInRange(*PrimesInRangeRequest, Calculator_PrimesInRangeServer) error
+	// Number theory
+	// IsPrime is deterministic for every int64. GreatestCommonDivisor and
+	// LeastCommonMultiple reduce a list of numbers, by absolute value.
+	// ModularPower and ModularInverse compute modulo a positive modulus, a
+	// negative exponent raising the inverse of the base. Totient counts the
+	// numbers in [1, number] coprime to number.
+	//
+	// error handling
+	// These RPCs will throw INVALID_ARGUMENT for an empty list of numbers, a
+	// non-positive modulus, a number with no inverse modulo the modulus or a
+	// non-positive totient argument, and OUT_OF_RANGE, with an ErrorInfo
+	// detail of reason INTEGER_OVERFLOW, if the result does not fit in an
+	// int64.
+	IsPrime(context.Context, *IsPrimeRequest) (*IsPrimeResponse, error)
+	GreatestCommonDivisor(context.Context, *GreatestCommonDivisorRequest) (*GreatestCommonDivisorResponse, error)
+	LeastCommonMultiple(context.Context, *LeastCommonMultipleRequest) (*LeastCommonMultipleResponse, error)
+	ModularPower(context.Context, *ModularPowerRequest) (*ModularPowerResponse, error)
+	ModularInverse(context.Context, *ModularInverseRequest) (*ModularInverseResponse, error)
+	Totient(context.Context, *TotientRequest) (*TotientResponse, error)
 	// Client stream
+	//
+	// error handling
+	// This RPC will throw INVALID_ARGUMENT if the stream holds no number or a
+	// number that is NaN or infinite, and OUT_OF_RANGE if the average
+	// overflows.
 	CalculateAverage(Calculator_CalculateAverageServer) error
+	// Client stream
+	// Describes the numbers of the stream. The median and percentiles are
+	// exact for the first 1024 numbers and estimated past that.
+	//
+	// error handling
+	// This RPC will throw INVALID_ARGUMENT if the stream holds no number or a
+	// number that is NaN or infinite, and OUT_OF_RANGE if the numbers are too
+	// large for the statistics to be finite.
+	CalculateStatistics(Calculator_CalculateStatisticsServer) error
 	// Bi-directional stream
 	FindMax(Calculator_FindMaxServer) error
+	// Bi-directional stream
+	// Aggregates the numbers of the stream over a sliding window. The first
+	// message holds the options, the following ones the numbers. The responses
+	// are sent as chosen by the emission policy of the options.
+	//
+	// error handling
+	// This RPC will throw INVALID_ARGUMENT if the first message does not hold
+	// valid options, if a later one holds options, or if a number is NaN or
+	// infinite, and RESOURCE_EXHAUSTED if a time window would hold more than
+	// 65536 numbers.
+	Aggregate(Calculator_AggregateServer) error
 	// Calculates the square root of a given number.
 	//
 	// error handling
 	// This RPC will throw INVALID_ARGUMENT if the number in the request is
 	// negative.
 	SquareRoot(context.Context, *SquareRootRequest) (*SquareRootResponse, error)
+	// Calculates the root of a given degree of a number. Odd-degree roots of
+	// negative numbers are real, even-degree ones are the principal complex
+	// root when complex results are requested.
+	//
+	// error handling
+	// This RPC will throw INVALID_ARGUMENT if the number is NaN or infinite,
+	// if more than 17 significant digits are requested, or if the number is
+	// negative, the degree even and complex results were not requested.
+	Root(context.Context, *RootRequest) (*RootResponse, error)
+	// Evaluates an infix expression such as "(x + y) * sqrt(z)" with the
+	// operators + - * / % ^, parentheses, the variables of the request and the
+	// functions sqrt, pow, abs, min, max and log.
+	//
+	// error handling
+	// This RPC will throw INVALID_ARGUMENT, with the position of the error in
+	// the message and a BadRequest detail, if the expression is malformed,
+	// longer than 1024 bytes, uses an undefined variable or does not evaluate
+	// to a finite number.
+	Evaluate(context.Context, *EvaluateRequest) (*EvaluateResponse, error)
+	// Runs many operations in one round trip, concurrently. The results are in
+	// the order of the operations, each either the response of the operation
+	// or the status it failed with. With fail_fast, the operations left once
+	// one failed are not run and fail with ABORTED. Each operation needs the
+	// scopes of the RPC it stands for, e.g. calc.factor for prime_decompose,
+	// and fails with PERMISSION_DENIED without them. Under rate limiting each
+	// operation also takes a token of that RPC, and fails with
+	// RESOURCE_EXHAUSTED when none is left.
+	//
+	// error handling
+	// This RPC will throw INVALID_ARGUMENT if the batch holds more than 1000
+	// operations. The failures of single operations do not fail the batch.
+	BatchCalculate(context.Context, *BatchCalculateRequest) (*BatchCalculateResponse, error)
+	// Sessions
+	// A session is selected by the x-session-id metadata of the requests, and
+	// created on first use. It keeps named memory registers, which Evaluate
+	// uses as variables, and the history of the unary operations called in
+	// it. Sessions idle for 30 minutes are deleted.
+	//
+	// The operands of the integer arithmetic, root and Big RPCs may name a
+	// register of the session instead of holding a value, e.g. x_register
+	// instead of x, but not both. Integer operands must name registers
+	// holding integers. Such requests are never answered from the response
+	// cache.
+	//
+	// error handling
+	// These RPCs will throw INVALID_ARGUMENT if the session ID is missing or
+	// too long, or if a register name is not an identifier, NOT_FOUND when
+	// recalling an unset register, and RESOURCE_EXHAUSTED if there are too
+	// many sessions, overall or created by the caller, or registers.
+	// Register operands fail the same way, and with INVALID_ARGUMENT when an
+	// operand sets both a value and a register, or when an integer operand
+	// names a register holding a fraction or a number out of the int64 range.
+	StoreMemory(context.Context, *StoreMemoryRequest) (*StoreMemoryResponse, error)
+	RecallMemory(context.Context, *RecallMemoryRequest) (*RecallMemoryResponse, error)
+	// Clears a register, or all of them when no name is given.
+	ClearMemory(context.Context, *ClearMemoryRequest) (*ClearMemoryResponse, error)
+	ListHistory(context.Context, *ListHistoryRequest) (*ListHistoryResponse, error)
+	// Arbitrary-precision arithmetic
+	// The Big RPCs take their operands as decimal strings of at most 320 KiB
+	// and compute on integers, exact rationals or floats of the requested
+	// precision.
+	//
+	// error handling
+	// These RPCs will throw INVALID_ARGUMENT if an operand is malformed, on
+	// division by zero or for a root that is not defined, and OUT_OF_RANGE if
+	// the result would be too large to compute.
+	// Adds x and y.
+	BigAdd(context.Context, *BigRequest) (*BigResponse, error)
+	// Subtracts y from x.
+	BigSubtract(context.Context, *BigRequest) (*BigResponse, error)
+	// Multiplies x by y.
+	BigMultiply(context.Context, *BigRequest) (*BigResponse, error)
+	// Divides x by y. INTEGER division truncates towards zero and returns the
+	// remainder.
+	BigDivide(context.Context, *BigRequest) (*BigResponse, error)
+	// Raises x to the integer power y.
+	BigPower(context.Context, *BigRequest) (*BigResponse, error)
+	// Takes the root of degree y of x. INTEGER roots are truncated towards zero
+	// and RATIONAL roots must be rational.
+	BigRoot(context.Context, *BigRequest) (*BigResponse, error)
 }
 
 // UnimplementedCalculatorServer can be embedded to have forward compatible implementations.
@@ -642,19 +4463,100 @@ type UnimplementedCalculatorServer struct {
 }
 
 func (*UnimplementedCalculatorServer) CalculateSum(ctx context.Context, req *CalculateSumRequest) (*CalculateSumResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CalculateSum not implemented")
+	return nil, status1.Errorf(codes.Unimplemented, "method CalculateSum not implemented")
+}
+func (*UnimplementedCalculatorServer) Subtract(ctx context.Context, req *SubtractRequest) (*SubtractResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method Subtract not implemented")
+}
+func (*UnimplementedCalculatorServer) Multiply(ctx context.Context, req *MultiplyRequest) (*MultiplyResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method Multiply not implemented")
+}
+func (*UnimplementedCalculatorServer) Divide(ctx context.Context, req *DivideRequest) (*DivideResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method Divide not implemented")
+}
+func (*UnimplementedCalculatorServer) Modulo(ctx context.Context, req *ModuloRequest) (*ModuloResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method Modulo not implemented")
+}
+func (*UnimplementedCalculatorServer) IntegerPower(ctx context.Context, req *IntegerPowerRequest) (*IntegerPowerResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method IntegerPower not implemented")
 }
 func (*UnimplementedCalculatorServer) PrimeDecompose(req *PrimeDecomposeRequest, srv Calculator_PrimeDecomposeServer) error {
-	return status.Errorf(codes.Unimplemented, "method PrimeDecompose not implemented")
+	return status1.Errorf(codes.Unimplemented, "method PrimeDecompose not implemented")
+}
+func (*UnimplementedCalculatorServer) PrimesInRange(req *PrimesInRangeRequest, srv Calculator_PrimesInRangeServer) error {
+	return status1.Errorf(codes.Unimplemented, "method PrimesInRange not implemented")
+}
+func (*UnimplementedCalculatorServer) IsPrime(ctx context.Context, req *IsPrimeRequest) (*IsPrimeResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method IsPrime not implemented")
+}
+func (*UnimplementedCalculatorServer) GreatestCommonDivisor(ctx context.Context, req *GreatestCommonDivisorRequest) (*GreatestCommonDivisorResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method GreatestCommonDivisor not implemented")
+}
+func (*UnimplementedCalculatorServer) LeastCommonMultiple(ctx context.Context, req *LeastCommonMultipleRequest) (*LeastCommonMultipleResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method LeastCommonMultiple not implemented")
+}
+func (*UnimplementedCalculatorServer) ModularPower(ctx context.Context, req *ModularPowerRequest) (*ModularPowerResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method ModularPower not implemented")
+}
+func (*UnimplementedCalculatorServer) ModularInverse(ctx context.Context, req *ModularInverseRequest) (*ModularInverseResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method ModularInverse not implemented")
+}
+func (*UnimplementedCalculatorServer) Totient(ctx context.Context, req *TotientRequest) (*TotientResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method Totient not implemented")
 }
 func (*UnimplementedCalculatorServer) CalculateAverage(srv Calculator_CalculateAverageServer) error {
-	return status.Errorf(codes.Unimplemented, "method CalculateAverage not implemented")
+	return status1.Errorf(codes.Unimplemented, "method CalculateAverage not implemented")
+}
+func (*UnimplementedCalculatorServer) CalculateStatistics(srv Calculator_CalculateStatisticsServer) error {
+	return status1.Errorf(codes.Unimplemented, "method CalculateStatistics not implemented")
 }
 func (*UnimplementedCalculatorServer) FindMax(srv Calculator_FindMaxServer) error {
-	return status.Errorf(codes.Unimplemented, "method FindMax not implemented")
+	return status1.Errorf(codes.Unimplemented, "method FindMax not implemented")
+}
+func (*UnimplementedCalculatorServer) Aggregate(srv Calculator_AggregateServer) error {
+	return status1.Errorf(codes.Unimplemented, "method Aggregate not implemented")
 }
 func (*UnimplementedCalculatorServer) SquareRoot(ctx context.Context, req *SquareRootRequest) (*SquareRootResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SquareRoot not implemented")
+	return nil, status1.Errorf(codes.Unimplemented, "method SquareRoot not implemented")
+}
+func (*UnimplementedCalculatorServer) Root(ctx context.Context, req *RootRequest) (*RootResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method Root not implemented")
+}
+func (*UnimplementedCalculatorServer) Evaluate(ctx context.Context, req *EvaluateRequest) (*EvaluateResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method Evaluate not implemented")
+}
+func (*UnimplementedCalculatorServer) BatchCalculate(ctx context.Context, req *BatchCalculateRequest) (*BatchCalculateResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method BatchCalculate not implemented")
+}
+func (*UnimplementedCalculatorServer) StoreMemory(ctx context.Context, req *StoreMemoryRequest) (*StoreMemoryResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method StoreMemory not implemented")
+}
+func (*UnimplementedCalculatorServer) RecallMemory(ctx context.Context, req *RecallMemoryRequest) (*RecallMemoryResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method RecallMemory not implemented")
+}
+func (*UnimplementedCalculatorServer) ClearMemory(ctx context.Context, req *ClearMemoryRequest) (*ClearMemoryResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method ClearMemory not implemented")
+}
+func (*UnimplementedCalculatorServer) ListHistory(ctx context.Context, req *ListHistoryRequest) (*ListHistoryResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method ListHistory not implemented")
+}
+func (*UnimplementedCalculatorServer) BigAdd(ctx context.Context, req *BigRequest) (*BigResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method BigAdd not implemented")
+}
+func (*UnimplementedCalculatorServer) BigSubtract(ctx context.Context, req *BigRequest) (*BigResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method BigSubtract not implemented")
+}
+func (*UnimplementedCalculatorServer) BigMultiply(ctx context.Context, req *BigRequest) (*BigResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method BigMultiply not implemented")
+}
+func (*UnimplementedCalculatorServer) BigDivide(ctx context.Context, req *BigRequest) (*BigResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method BigDivide not implemented")
+}
+func (*UnimplementedCalculatorServer) BigPower(ctx context.Context, req *BigRequest) (*BigResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method BigPower not implemented")
+}
+func (*UnimplementedCalculatorServer) BigRoot(ctx context.Context, req *BigRequest) (*BigResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method BigRoot not implemented")
 }
 
 func RegisterCalculatorServer(s *grpc.Server, srv CalculatorServer) {
@@ -679,6 +4581,96 @@ func _Calculator_CalculateSum_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Calculator_Subtract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubtractRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServer).Subtract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calc.Calculator/Subtract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServer).Subtract(ctx, req.(*SubtractRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Calculator_Multiply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MultiplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServer).Multiply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calc.Calculator/Multiply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServer).Multiply(ctx, req.(*MultiplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Calculator_Divide_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DivideRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServer).Divide(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calc.Calculator/Divide",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServer).Divide(ctx, req.(*DivideRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Calculator_Modulo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModuloRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServer).Modulo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calc.Calculator/Modulo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServer).Modulo(ctx, req.(*ModuloRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Calculator_IntegerPower_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IntegerPowerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServer).IntegerPower(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calc.Calculator/IntegerPower",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServer).IntegerPower(ctx, req.(*IntegerPowerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Calculator_PrimeDecompose_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(PrimeDecomposeRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -700,26 +4692,181 @@ func (x *calculatorPrimeDecomposeServer) Send(m *PrimeDecomposeResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func _Calculator_CalculateAverage_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(CalculatorServer).CalculateAverage(&calculatorCalculateAverageServer{stream})
+func _Calculator_PrimesInRange_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PrimesInRangeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CalculatorServer).PrimesInRange(m, &calculatorPrimesInRangeServer{stream})
+}
+
+type Calculator_PrimesInRangeServer interface {
+	Send(*PrimesInRangeResponse) error
+	grpc.ServerStream
+}
+
+type calculatorPrimesInRangeServer struct {
+	grpc.ServerStream
+}
+
+func (x *calculatorPrimesInRangeServer) Send(m *PrimesInRangeResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Calculator_IsPrime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IsPrimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServer).IsPrime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calc.Calculator/IsPrime",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServer).IsPrime(ctx, req.(*IsPrimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Calculator_GreatestCommonDivisor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GreatestCommonDivisorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServer).GreatestCommonDivisor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calc.Calculator/GreatestCommonDivisor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServer).GreatestCommonDivisor(ctx, req.(*GreatestCommonDivisorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Calculator_LeastCommonMultiple_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeastCommonMultipleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServer).LeastCommonMultiple(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calc.Calculator/LeastCommonMultiple",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServer).LeastCommonMultiple(ctx, req.(*LeastCommonMultipleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Calculator_ModularPower_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModularPowerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServer).ModularPower(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calc.Calculator/ModularPower",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServer).ModularPower(ctx, req.(*ModularPowerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Calculator_ModularInverse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModularInverseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServer).ModularInverse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calc.Calculator/ModularInverse",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServer).ModularInverse(ctx, req.(*ModularInverseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Calculator_Totient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TotientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServer).Totient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calc.Calculator/Totient",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServer).Totient(ctx, req.(*TotientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Calculator_CalculateAverage_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(CalculatorServer).CalculateAverage(&calculatorCalculateAverageServer{stream})
+}
+
+type Calculator_CalculateAverageServer interface {
+	SendAndClose(*CalculateAverageResponse) error
+	Recv() (*CalculateAverageRequest, error)
+	grpc.ServerStream
+}
+
+type calculatorCalculateAverageServer struct {
+	grpc.ServerStream
+}
+
+func (x *calculatorCalculateAverageServer) SendAndClose(m *CalculateAverageResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *calculatorCalculateAverageServer) Recv() (*CalculateAverageRequest, error) {
+	m := new(CalculateAverageRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Calculator_CalculateStatistics_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(CalculatorServer).CalculateStatistics(&calculatorCalculateStatisticsServer{stream})
 }
 
-type Calculator_CalculateAverageServer interface {
-	SendAndClose(*CalculateAverageResponse) error
-	Recv() (*CalculateAverageRequest, error)
+type Calculator_CalculateStatisticsServer interface {
+	SendAndClose(*CalculateStatisticsResponse) error
+	Recv() (*CalculateStatisticsRequest, error)
 	grpc.ServerStream
 }
 
-type calculatorCalculateAverageServer struct {
+type calculatorCalculateStatisticsServer struct {
 	grpc.ServerStream
 }
 
-func (x *calculatorCalculateAverageServer) SendAndClose(m *CalculateAverageResponse) error {
+func (x *calculatorCalculateStatisticsServer) SendAndClose(m *CalculateStatisticsResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func (x *calculatorCalculateAverageServer) Recv() (*CalculateAverageRequest, error) {
-	m := new(CalculateAverageRequest)
+func (x *calculatorCalculateStatisticsServer) Recv() (*CalculateStatisticsRequest, error) {
+	m := new(CalculateStatisticsRequest)
 	if err := x.ServerStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
@@ -752,6 +4899,32 @@ func (x *calculatorFindMaxServer) Recv() (*FindMaxRequest, error) {
 	return m, nil
 }
 
+func _Calculator_Aggregate_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(CalculatorServer).Aggregate(&calculatorAggregateServer{stream})
+}
+
+type Calculator_AggregateServer interface {
+	Send(*AggregateResponse) error
+	Recv() (*AggregateRequest, error)
+	grpc.ServerStream
+}
+
+type calculatorAggregateServer struct {
+	grpc.ServerStream
+}
+
+func (x *calculatorAggregateServer) Send(m *AggregateResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *calculatorAggregateServer) Recv() (*AggregateRequest, error) {
+	m := new(AggregateRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func _Calculator_SquareRoot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SquareRootRequest)
 	if err := dec(in); err != nil {
@@ -770,6 +4943,240 @@ func _Calculator_SquareRoot_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Calculator_Root_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RootRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServer).Root(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calc.Calculator/Root",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServer).Root(ctx, req.(*RootRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Calculator_Evaluate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvaluateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServer).Evaluate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calc.Calculator/Evaluate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServer).Evaluate(ctx, req.(*EvaluateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Calculator_BatchCalculate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCalculateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServer).BatchCalculate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calc.Calculator/BatchCalculate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServer).BatchCalculate(ctx, req.(*BatchCalculateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Calculator_StoreMemory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StoreMemoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServer).StoreMemory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calc.Calculator/StoreMemory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServer).StoreMemory(ctx, req.(*StoreMemoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Calculator_RecallMemory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecallMemoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServer).RecallMemory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calc.Calculator/RecallMemory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServer).RecallMemory(ctx, req.(*RecallMemoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Calculator_ClearMemory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearMemoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServer).ClearMemory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calc.Calculator/ClearMemory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServer).ClearMemory(ctx, req.(*ClearMemoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Calculator_ListHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServer).ListHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calc.Calculator/ListHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServer).ListHistory(ctx, req.(*ListHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Calculator_BigAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServer).BigAdd(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calc.Calculator/BigAdd",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServer).BigAdd(ctx, req.(*BigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Calculator_BigSubtract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServer).BigSubtract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calc.Calculator/BigSubtract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServer).BigSubtract(ctx, req.(*BigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Calculator_BigMultiply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServer).BigMultiply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calc.Calculator/BigMultiply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServer).BigMultiply(ctx, req.(*BigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Calculator_BigDivide_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServer).BigDivide(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calc.Calculator/BigDivide",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServer).BigDivide(ctx, req.(*BigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Calculator_BigPower_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServer).BigPower(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calc.Calculator/BigPower",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServer).BigPower(ctx, req.(*BigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Calculator_BigRoot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServer).BigRoot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/calc.Calculator/BigRoot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServer).BigRoot(ctx, req.(*BigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Calculator_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "calc.Calculator",
 	HandlerType: (*CalculatorServer)(nil),
@@ -778,10 +5185,106 @@ var _Calculator_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CalculateSum",
 			Handler:    _Calculator_CalculateSum_Handler,
 		},
+		{
+			MethodName: "Subtract",
+			Handler:    _Calculator_Subtract_Handler,
+		},
+		{
+			MethodName: "Multiply",
+			Handler:    _Calculator_Multiply_Handler,
+		},
+		{
+			MethodName: "Divide",
+			Handler:    _Calculator_Divide_Handler,
+		},
+		{
+			MethodName: "Modulo",
+			Handler:    _Calculator_Modulo_Handler,
+		},
+		{
+			MethodName: "IntegerPower",
+			Handler:    _Calculator_IntegerPower_Handler,
+		},
+		{
+			MethodName: "IsPrime",
+			Handler:    _Calculator_IsPrime_Handler,
+		},
+		{
+			MethodName: "GreatestCommonDivisor",
+			Handler:    _Calculator_GreatestCommonDivisor_Handler,
+		},
+		{
+			MethodName: "LeastCommonMultiple",
+			Handler:    _Calculator_LeastCommonMultiple_Handler,
+		},
+		{
+			MethodName: "ModularPower",
+			Handler:    _Calculator_ModularPower_Handler,
+		},
+		{
+			MethodName: "ModularInverse",
+			Handler:    _Calculator_ModularInverse_Handler,
+		},
+		{
+			MethodName: "Totient",
+			Handler:    _Calculator_Totient_Handler,
+		},
 		{
 			MethodName: "SquareRoot",
 			Handler:    _Calculator_SquareRoot_Handler,
 		},
+		{
+			MethodName: "Root",
+			Handler:    _Calculator_Root_Handler,
+		},
+		{
+			MethodName: "Evaluate",
+			Handler:    _Calculator_Evaluate_Handler,
+		},
+		{
+			MethodName: "BatchCalculate",
+			Handler:    _Calculator_BatchCalculate_Handler,
+		},
+		{
+			MethodName: "StoreMemory",
+			Handler:    _Calculator_StoreMemory_Handler,
+		},
+		{
+			MethodName: "RecallMemory",
+			Handler:    _Calculator_RecallMemory_Handler,
+		},
+		{
+			MethodName: "ClearMemory",
+			Handler:    _Calculator_ClearMemory_Handler,
+		},
+		{
+			MethodName: "ListHistory",
+			Handler:    _Calculator_ListHistory_Handler,
+		},
+		{
+			MethodName: "BigAdd",
+			Handler:    _Calculator_BigAdd_Handler,
+		},
+		{
+			MethodName: "BigSubtract",
+			Handler:    _Calculator_BigSubtract_Handler,
+		},
+		{
+			MethodName: "BigMultiply",
+			Handler:    _Calculator_BigMultiply_Handler,
+		},
+		{
+			MethodName: "BigDivide",
+			Handler:    _Calculator_BigDivide_Handler,
+		},
+		{
+			MethodName: "BigPower",
+			Handler:    _Calculator_BigPower_Handler,
+		},
+		{
+			MethodName: "BigRoot",
+			Handler:    _Calculator_BigRoot_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -789,17 +5292,33 @@ var _Calculator_serviceDesc = grpc.ServiceDesc{
 			Handler:       _Calculator_PrimeDecompose_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "PrimesInRange",
+			Handler:       _Calculator_PrimesInRange_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "CalculateAverage",
 			Handler:       _Calculator_CalculateAverage_Handler,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "CalculateStatistics",
+			Handler:       _Calculator_CalculateStatistics_Handler,
+			ClientStreams: true,
+		},
 		{
 			StreamName:    "FindMax",
 			Handler:       _Calculator_FindMax_Handler,
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "Aggregate",
+			Handler:       _Calculator_Aggregate_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "calc/calc_proto/calc.proto",
 }
@@ -0,0 +1,8087 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: calc/calc_proto/calc.proto
+
+package calcpb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on FindMaxRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *FindMaxRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FindMaxRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in FindMaxRequestMultiError,
+// or nil if none found.
+func (m *FindMaxRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FindMaxRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Number
+
+	if len(errors) > 0 {
+		return FindMaxRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// FindMaxRequestMultiError is an error wrapping multiple validation errors
+// returned by FindMaxRequest.ValidateAll() if the designated constraints
+// aren't met.
+type FindMaxRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FindMaxRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FindMaxRequestMultiError) AllErrors() []error { return m }
+
+// FindMaxRequestValidationError is the validation error returned by
+// FindMaxRequest.Validate if the designated constraints aren't met.
+type FindMaxRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FindMaxRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FindMaxRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FindMaxRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FindMaxRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FindMaxRequestValidationError) ErrorName() string { return "FindMaxRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FindMaxRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFindMaxRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FindMaxRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FindMaxRequestValidationError{}
+
+// Validate checks the field values on FindMaxResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *FindMaxResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FindMaxResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FindMaxResponseMultiError, or nil if none found.
+func (m *FindMaxResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FindMaxResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Number
+
+	if len(errors) > 0 {
+		return FindMaxResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// FindMaxResponseMultiError is an error wrapping multiple validation errors
+// returned by FindMaxResponse.ValidateAll() if the designated constraints
+// aren't met.
+type FindMaxResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FindMaxResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FindMaxResponseMultiError) AllErrors() []error { return m }
+
+// FindMaxResponseValidationError is the validation error returned by
+// FindMaxResponse.Validate if the designated constraints aren't met.
+type FindMaxResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FindMaxResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FindMaxResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FindMaxResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FindMaxResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FindMaxResponseValidationError) ErrorName() string { return "FindMaxResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FindMaxResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFindMaxResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FindMaxResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FindMaxResponseValidationError{}
+
+// Validate checks the field values on AggregateOptions with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AggregateOptions) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AggregateOptions with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AggregateOptionsMultiError, or nil if none found.
+func (m *AggregateOptions) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AggregateOptions) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := AggregateOptions_Aggregation_name[int32(m.GetAggregation())]; !ok {
+		err := AggregateOptionsValidationError{
+			field:  "Aggregation",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetWindowSize() > 65536 {
+		err := AggregateOptionsValidationError{
+			field:  "WindowSize",
+			reason: "value must be less than or equal to 65536",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetWindowDuration()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AggregateOptionsValidationError{
+					field:  "WindowDuration",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AggregateOptionsValidationError{
+					field:  "WindowDuration",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWindowDuration()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AggregateOptionsValidationError{
+				field:  "WindowDuration",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if _, ok := AggregateOptions_Emission_name[int32(m.GetEmission())]; !ok {
+		err := AggregateOptionsValidationError{
+			field:  "Emission",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetEmitInterval()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AggregateOptionsValidationError{
+					field:  "EmitInterval",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AggregateOptionsValidationError{
+					field:  "EmitInterval",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEmitInterval()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AggregateOptionsValidationError{
+				field:  "EmitInterval",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AggregateOptionsMultiError(errors)
+	}
+
+	return nil
+}
+
+// AggregateOptionsMultiError is an error wrapping multiple validation errors
+// returned by AggregateOptions.ValidateAll() if the designated constraints
+// aren't met.
+type AggregateOptionsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AggregateOptionsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AggregateOptionsMultiError) AllErrors() []error { return m }
+
+// AggregateOptionsValidationError is the validation error returned by
+// AggregateOptions.Validate if the designated constraints aren't met.
+type AggregateOptionsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AggregateOptionsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AggregateOptionsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AggregateOptionsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AggregateOptionsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AggregateOptionsValidationError) ErrorName() string { return "AggregateOptionsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AggregateOptionsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAggregateOptions.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AggregateOptionsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AggregateOptionsValidationError{}
+
+// Validate checks the field values on AggregateRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AggregateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AggregateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AggregateRequestMultiError, or nil if none found.
+func (m *AggregateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AggregateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	switch v := m.Request.(type) {
+	case *AggregateRequest_Options:
+		if v == nil {
+			err := AggregateRequestValidationError{
+				field:  "Request",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetOptions()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AggregateRequestValidationError{
+						field:  "Options",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AggregateRequestValidationError{
+						field:  "Options",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AggregateRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *AggregateRequest_Number:
+		if v == nil {
+			err := AggregateRequestValidationError{
+				field:  "Request",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for Number
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return AggregateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AggregateRequestMultiError is an error wrapping multiple validation errors
+// returned by AggregateRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AggregateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AggregateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AggregateRequestMultiError) AllErrors() []error { return m }
+
+// AggregateRequestValidationError is the validation error returned by
+// AggregateRequest.Validate if the designated constraints aren't met.
+type AggregateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AggregateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AggregateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AggregateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AggregateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AggregateRequestValidationError) ErrorName() string { return "AggregateRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AggregateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAggregateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AggregateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AggregateRequestValidationError{}
+
+// Validate checks the field values on AggregateResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AggregateResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AggregateResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AggregateResponseMultiError, or nil if none found.
+func (m *AggregateResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AggregateResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Value
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return AggregateResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AggregateResponseMultiError is an error wrapping multiple validation errors
+// returned by AggregateResponse.ValidateAll() if the designated constraints
+// aren't met.
+type AggregateResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AggregateResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AggregateResponseMultiError) AllErrors() []error { return m }
+
+// AggregateResponseValidationError is the validation error returned by
+// AggregateResponse.Validate if the designated constraints aren't met.
+type AggregateResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AggregateResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AggregateResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AggregateResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AggregateResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AggregateResponseValidationError) ErrorName() string {
+	return "AggregateResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AggregateResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAggregateResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AggregateResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AggregateResponseValidationError{}
+
+// Validate checks the field values on CalculateAverageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CalculateAverageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CalculateAverageRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CalculateAverageRequestMultiError, or nil if none found.
+func (m *CalculateAverageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CalculateAverageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Number
+
+	if len(errors) > 0 {
+		return CalculateAverageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CalculateAverageRequestMultiError is an error wrapping multiple validation
+// errors returned by CalculateAverageRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CalculateAverageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CalculateAverageRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CalculateAverageRequestMultiError) AllErrors() []error { return m }
+
+// CalculateAverageRequestValidationError is the validation error returned by
+// CalculateAverageRequest.Validate if the designated constraints aren't met.
+type CalculateAverageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CalculateAverageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CalculateAverageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CalculateAverageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CalculateAverageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CalculateAverageRequestValidationError) ErrorName() string {
+	return "CalculateAverageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CalculateAverageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCalculateAverageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CalculateAverageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CalculateAverageRequestValidationError{}
+
+// Validate checks the field values on CalculateAverageResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CalculateAverageResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CalculateAverageResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CalculateAverageResponseMultiError, or nil if none found.
+func (m *CalculateAverageResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CalculateAverageResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Average
+
+	if len(errors) > 0 {
+		return CalculateAverageResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CalculateAverageResponseMultiError is an error wrapping multiple validation
+// errors returned by CalculateAverageResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CalculateAverageResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CalculateAverageResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CalculateAverageResponseMultiError) AllErrors() []error { return m }
+
+// CalculateAverageResponseValidationError is the validation error returned by
+// CalculateAverageResponse.Validate if the designated constraints aren't met.
+type CalculateAverageResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CalculateAverageResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CalculateAverageResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CalculateAverageResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CalculateAverageResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CalculateAverageResponseValidationError) ErrorName() string {
+	return "CalculateAverageResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CalculateAverageResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCalculateAverageResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CalculateAverageResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CalculateAverageResponseValidationError{}
+
+// Validate checks the field values on CalculateStatisticsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CalculateStatisticsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CalculateStatisticsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CalculateStatisticsRequestMultiError, or nil if none found.
+func (m *CalculateStatisticsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CalculateStatisticsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Number
+
+	if len(errors) > 0 {
+		return CalculateStatisticsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CalculateStatisticsRequestMultiError is an error wrapping multiple
+// validation errors returned by CalculateStatisticsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type CalculateStatisticsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CalculateStatisticsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CalculateStatisticsRequestMultiError) AllErrors() []error { return m }
+
+// CalculateStatisticsRequestValidationError is the validation error returned
+// by CalculateStatisticsRequest.Validate if the designated constraints aren't met.
+type CalculateStatisticsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CalculateStatisticsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CalculateStatisticsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CalculateStatisticsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CalculateStatisticsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CalculateStatisticsRequestValidationError) ErrorName() string {
+	return "CalculateStatisticsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CalculateStatisticsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCalculateStatisticsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CalculateStatisticsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CalculateStatisticsRequestValidationError{}
+
+// Validate checks the field values on CalculateStatisticsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CalculateStatisticsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CalculateStatisticsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CalculateStatisticsResponseMultiError, or nil if none found.
+func (m *CalculateStatisticsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CalculateStatisticsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Count
+
+	// no validation rules for Mean
+
+	// no validation rules for Variance
+
+	// no validation rules for StandardDeviation
+
+	// no validation rules for Min
+
+	// no validation rules for Max
+
+	// no validation rules for Median
+
+	// no validation rules for P90
+
+	// no validation rules for P99
+
+	// no validation rules for Approximate
+
+	if len(errors) > 0 {
+		return CalculateStatisticsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CalculateStatisticsResponseMultiError is an error wrapping multiple
+// validation errors returned by CalculateStatisticsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type CalculateStatisticsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CalculateStatisticsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CalculateStatisticsResponseMultiError) AllErrors() []error { return m }
+
+// CalculateStatisticsResponseValidationError is the validation error returned
+// by CalculateStatisticsResponse.Validate if the designated constraints
+// aren't met.
+type CalculateStatisticsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CalculateStatisticsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CalculateStatisticsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CalculateStatisticsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CalculateStatisticsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CalculateStatisticsResponseValidationError) ErrorName() string {
+	return "CalculateStatisticsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CalculateStatisticsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCalculateStatisticsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CalculateStatisticsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CalculateStatisticsResponseValidationError{}
+
+// Validate checks the field values on CalculateSumRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CalculateSumRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CalculateSumRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CalculateSumRequestMultiError, or nil if none found.
+func (m *CalculateSumRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CalculateSumRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for X
+
+	if utf8.RuneCountInString(m.GetXRegister()) > 64 {
+		err := CalculateSumRequestValidationError{
+			field:  "XRegister",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_CalculateSumRequest_XRegister_Pattern.MatchString(m.GetXRegister()) {
+		err := CalculateSumRequestValidationError{
+			field:  "XRegister",
+			reason: "value does not match regex pattern \"^([A-Za-z_][A-Za-z0-9_]*)?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Y
+
+	if utf8.RuneCountInString(m.GetYRegister()) > 64 {
+		err := CalculateSumRequestValidationError{
+			field:  "YRegister",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_CalculateSumRequest_YRegister_Pattern.MatchString(m.GetYRegister()) {
+		err := CalculateSumRequestValidationError{
+			field:  "YRegister",
+			reason: "value does not match regex pattern \"^([A-Za-z_][A-Za-z0-9_]*)?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CalculateSumRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CalculateSumRequestMultiError is an error wrapping multiple validation
+// errors returned by CalculateSumRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CalculateSumRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CalculateSumRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CalculateSumRequestMultiError) AllErrors() []error { return m }
+
+// CalculateSumRequestValidationError is the validation error returned by
+// CalculateSumRequest.Validate if the designated constraints aren't met.
+type CalculateSumRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CalculateSumRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CalculateSumRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CalculateSumRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CalculateSumRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CalculateSumRequestValidationError) ErrorName() string {
+	return "CalculateSumRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CalculateSumRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCalculateSumRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CalculateSumRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CalculateSumRequestValidationError{}
+
+var _CalculateSumRequest_XRegister_Pattern = regexp.MustCompile("^([A-Za-z_][A-Za-z0-9_]*)?$")
+
+var _CalculateSumRequest_YRegister_Pattern = regexp.MustCompile("^([A-Za-z_][A-Za-z0-9_]*)?$")
+
+// Validate checks the field values on CalculateSumResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CalculateSumResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CalculateSumResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CalculateSumResponseMultiError, or nil if none found.
+func (m *CalculateSumResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CalculateSumResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Result
+
+	if len(errors) > 0 {
+		return CalculateSumResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CalculateSumResponseMultiError is an error wrapping multiple validation
+// errors returned by CalculateSumResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CalculateSumResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CalculateSumResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CalculateSumResponseMultiError) AllErrors() []error { return m }
+
+// CalculateSumResponseValidationError is the validation error returned by
+// CalculateSumResponse.Validate if the designated constraints aren't met.
+type CalculateSumResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CalculateSumResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CalculateSumResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CalculateSumResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CalculateSumResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CalculateSumResponseValidationError) ErrorName() string {
+	return "CalculateSumResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CalculateSumResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCalculateSumResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CalculateSumResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CalculateSumResponseValidationError{}
+
+// Validate checks the field values on SubtractRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SubtractRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SubtractRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SubtractRequestMultiError, or nil if none found.
+func (m *SubtractRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SubtractRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for X
+
+	if utf8.RuneCountInString(m.GetXRegister()) > 64 {
+		err := SubtractRequestValidationError{
+			field:  "XRegister",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_SubtractRequest_XRegister_Pattern.MatchString(m.GetXRegister()) {
+		err := SubtractRequestValidationError{
+			field:  "XRegister",
+			reason: "value does not match regex pattern \"^([A-Za-z_][A-Za-z0-9_]*)?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Y
+
+	if utf8.RuneCountInString(m.GetYRegister()) > 64 {
+		err := SubtractRequestValidationError{
+			field:  "YRegister",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_SubtractRequest_YRegister_Pattern.MatchString(m.GetYRegister()) {
+		err := SubtractRequestValidationError{
+			field:  "YRegister",
+			reason: "value does not match regex pattern \"^([A-Za-z_][A-Za-z0-9_]*)?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SubtractRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SubtractRequestMultiError is an error wrapping multiple validation errors
+// returned by SubtractRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SubtractRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SubtractRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SubtractRequestMultiError) AllErrors() []error { return m }
+
+// SubtractRequestValidationError is the validation error returned by
+// SubtractRequest.Validate if the designated constraints aren't met.
+type SubtractRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SubtractRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SubtractRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SubtractRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SubtractRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SubtractRequestValidationError) ErrorName() string { return "SubtractRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SubtractRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSubtractRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SubtractRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SubtractRequestValidationError{}
+
+var _SubtractRequest_XRegister_Pattern = regexp.MustCompile("^([A-Za-z_][A-Za-z0-9_]*)?$")
+
+var _SubtractRequest_YRegister_Pattern = regexp.MustCompile("^([A-Za-z_][A-Za-z0-9_]*)?$")
+
+// Validate checks the field values on SubtractResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SubtractResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SubtractResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SubtractResponseMultiError, or nil if none found.
+func (m *SubtractResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SubtractResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Result
+
+	if len(errors) > 0 {
+		return SubtractResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SubtractResponseMultiError is an error wrapping multiple validation errors
+// returned by SubtractResponse.ValidateAll() if the designated constraints
+// aren't met.
+type SubtractResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SubtractResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SubtractResponseMultiError) AllErrors() []error { return m }
+
+// SubtractResponseValidationError is the validation error returned by
+// SubtractResponse.Validate if the designated constraints aren't met.
+type SubtractResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SubtractResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SubtractResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SubtractResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SubtractResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SubtractResponseValidationError) ErrorName() string { return "SubtractResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SubtractResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSubtractResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SubtractResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SubtractResponseValidationError{}
+
+// Validate checks the field values on MultiplyRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MultiplyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MultiplyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MultiplyRequestMultiError, or nil if none found.
+func (m *MultiplyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MultiplyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for X
+
+	if utf8.RuneCountInString(m.GetXRegister()) > 64 {
+		err := MultiplyRequestValidationError{
+			field:  "XRegister",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_MultiplyRequest_XRegister_Pattern.MatchString(m.GetXRegister()) {
+		err := MultiplyRequestValidationError{
+			field:  "XRegister",
+			reason: "value does not match regex pattern \"^([A-Za-z_][A-Za-z0-9_]*)?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Y
+
+	if utf8.RuneCountInString(m.GetYRegister()) > 64 {
+		err := MultiplyRequestValidationError{
+			field:  "YRegister",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_MultiplyRequest_YRegister_Pattern.MatchString(m.GetYRegister()) {
+		err := MultiplyRequestValidationError{
+			field:  "YRegister",
+			reason: "value does not match regex pattern \"^([A-Za-z_][A-Za-z0-9_]*)?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return MultiplyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// MultiplyRequestMultiError is an error wrapping multiple validation errors
+// returned by MultiplyRequest.ValidateAll() if the designated constraints
+// aren't met.
+type MultiplyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MultiplyRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MultiplyRequestMultiError) AllErrors() []error { return m }
+
+// MultiplyRequestValidationError is the validation error returned by
+// MultiplyRequest.Validate if the designated constraints aren't met.
+type MultiplyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MultiplyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MultiplyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MultiplyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MultiplyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MultiplyRequestValidationError) ErrorName() string { return "MultiplyRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MultiplyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMultiplyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MultiplyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MultiplyRequestValidationError{}
+
+var _MultiplyRequest_XRegister_Pattern = regexp.MustCompile("^([A-Za-z_][A-Za-z0-9_]*)?$")
+
+var _MultiplyRequest_YRegister_Pattern = regexp.MustCompile("^([A-Za-z_][A-Za-z0-9_]*)?$")
+
+// Validate checks the field values on MultiplyResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MultiplyResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MultiplyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MultiplyResponseMultiError, or nil if none found.
+func (m *MultiplyResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MultiplyResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Result
+
+	if len(errors) > 0 {
+		return MultiplyResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// MultiplyResponseMultiError is an error wrapping multiple validation errors
+// returned by MultiplyResponse.ValidateAll() if the designated constraints
+// aren't met.
+type MultiplyResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MultiplyResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MultiplyResponseMultiError) AllErrors() []error { return m }
+
+// MultiplyResponseValidationError is the validation error returned by
+// MultiplyResponse.Validate if the designated constraints aren't met.
+type MultiplyResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MultiplyResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MultiplyResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MultiplyResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MultiplyResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MultiplyResponseValidationError) ErrorName() string { return "MultiplyResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MultiplyResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMultiplyResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MultiplyResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MultiplyResponseValidationError{}
+
+// Validate checks the field values on DivideRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DivideRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DivideRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DivideRequestMultiError, or
+// nil if none found.
+func (m *DivideRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DivideRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for X
+
+	if utf8.RuneCountInString(m.GetXRegister()) > 64 {
+		err := DivideRequestValidationError{
+			field:  "XRegister",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_DivideRequest_XRegister_Pattern.MatchString(m.GetXRegister()) {
+		err := DivideRequestValidationError{
+			field:  "XRegister",
+			reason: "value does not match regex pattern \"^([A-Za-z_][A-Za-z0-9_]*)?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Y
+
+	if utf8.RuneCountInString(m.GetYRegister()) > 64 {
+		err := DivideRequestValidationError{
+			field:  "YRegister",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_DivideRequest_YRegister_Pattern.MatchString(m.GetYRegister()) {
+		err := DivideRequestValidationError{
+			field:  "YRegister",
+			reason: "value does not match regex pattern \"^([A-Za-z_][A-Za-z0-9_]*)?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DivideRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DivideRequestMultiError is an error wrapping multiple validation errors
+// returned by DivideRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DivideRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DivideRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DivideRequestMultiError) AllErrors() []error { return m }
+
+// DivideRequestValidationError is the validation error returned by
+// DivideRequest.Validate if the designated constraints aren't met.
+type DivideRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DivideRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DivideRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DivideRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DivideRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DivideRequestValidationError) ErrorName() string { return "DivideRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DivideRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDivideRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DivideRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DivideRequestValidationError{}
+
+var _DivideRequest_XRegister_Pattern = regexp.MustCompile("^([A-Za-z_][A-Za-z0-9_]*)?$")
+
+var _DivideRequest_YRegister_Pattern = regexp.MustCompile("^([A-Za-z_][A-Za-z0-9_]*)?$")
+
+// Validate checks the field values on DivideResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DivideResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DivideResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DivideResponseMultiError,
+// or nil if none found.
+func (m *DivideResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DivideResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Quotient
+
+	// no validation rules for Remainder
+
+	if len(errors) > 0 {
+		return DivideResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DivideResponseMultiError is an error wrapping multiple validation errors
+// returned by DivideResponse.ValidateAll() if the designated constraints
+// aren't met.
+type DivideResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DivideResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DivideResponseMultiError) AllErrors() []error { return m }
+
+// DivideResponseValidationError is the validation error returned by
+// DivideResponse.Validate if the designated constraints aren't met.
+type DivideResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DivideResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DivideResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DivideResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DivideResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DivideResponseValidationError) ErrorName() string { return "DivideResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DivideResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDivideResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DivideResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DivideResponseValidationError{}
+
+// Validate checks the field values on ModuloRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ModuloRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ModuloRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ModuloRequestMultiError, or
+// nil if none found.
+func (m *ModuloRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ModuloRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for X
+
+	if utf8.RuneCountInString(m.GetXRegister()) > 64 {
+		err := ModuloRequestValidationError{
+			field:  "XRegister",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_ModuloRequest_XRegister_Pattern.MatchString(m.GetXRegister()) {
+		err := ModuloRequestValidationError{
+			field:  "XRegister",
+			reason: "value does not match regex pattern \"^([A-Za-z_][A-Za-z0-9_]*)?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Y
+
+	if utf8.RuneCountInString(m.GetYRegister()) > 64 {
+		err := ModuloRequestValidationError{
+			field:  "YRegister",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_ModuloRequest_YRegister_Pattern.MatchString(m.GetYRegister()) {
+		err := ModuloRequestValidationError{
+			field:  "YRegister",
+			reason: "value does not match regex pattern \"^([A-Za-z_][A-Za-z0-9_]*)?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ModuloRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ModuloRequestMultiError is an error wrapping multiple validation errors
+// returned by ModuloRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ModuloRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ModuloRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ModuloRequestMultiError) AllErrors() []error { return m }
+
+// ModuloRequestValidationError is the validation error returned by
+// ModuloRequest.Validate if the designated constraints aren't met.
+type ModuloRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ModuloRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ModuloRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ModuloRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ModuloRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ModuloRequestValidationError) ErrorName() string { return "ModuloRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ModuloRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sModuloRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ModuloRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ModuloRequestValidationError{}
+
+var _ModuloRequest_XRegister_Pattern = regexp.MustCompile("^([A-Za-z_][A-Za-z0-9_]*)?$")
+
+var _ModuloRequest_YRegister_Pattern = regexp.MustCompile("^([A-Za-z_][A-Za-z0-9_]*)?$")
+
+// Validate checks the field values on ModuloResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ModuloResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ModuloResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ModuloResponseMultiError,
+// or nil if none found.
+func (m *ModuloResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ModuloResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Result
+
+	if len(errors) > 0 {
+		return ModuloResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ModuloResponseMultiError is an error wrapping multiple validation errors
+// returned by ModuloResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ModuloResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ModuloResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ModuloResponseMultiError) AllErrors() []error { return m }
+
+// ModuloResponseValidationError is the validation error returned by
+// ModuloResponse.Validate if the designated constraints aren't met.
+type ModuloResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ModuloResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ModuloResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ModuloResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ModuloResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ModuloResponseValidationError) ErrorName() string { return "ModuloResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ModuloResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sModuloResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ModuloResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ModuloResponseValidationError{}
+
+// Validate checks the field values on IntegerPowerRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *IntegerPowerRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IntegerPowerRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// IntegerPowerRequestMultiError, or nil if none found.
+func (m *IntegerPowerRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IntegerPowerRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Base
+
+	if utf8.RuneCountInString(m.GetBaseRegister()) > 64 {
+		err := IntegerPowerRequestValidationError{
+			field:  "BaseRegister",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_IntegerPowerRequest_BaseRegister_Pattern.MatchString(m.GetBaseRegister()) {
+		err := IntegerPowerRequestValidationError{
+			field:  "BaseRegister",
+			reason: "value does not match regex pattern \"^([A-Za-z_][A-Za-z0-9_]*)?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Exponent
+
+	if utf8.RuneCountInString(m.GetExponentRegister()) > 64 {
+		err := IntegerPowerRequestValidationError{
+			field:  "ExponentRegister",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_IntegerPowerRequest_ExponentRegister_Pattern.MatchString(m.GetExponentRegister()) {
+		err := IntegerPowerRequestValidationError{
+			field:  "ExponentRegister",
+			reason: "value does not match regex pattern \"^([A-Za-z_][A-Za-z0-9_]*)?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return IntegerPowerRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// IntegerPowerRequestMultiError is an error wrapping multiple validation
+// errors returned by IntegerPowerRequest.ValidateAll() if the designated
+// constraints aren't met.
+type IntegerPowerRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IntegerPowerRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IntegerPowerRequestMultiError) AllErrors() []error { return m }
+
+// IntegerPowerRequestValidationError is the validation error returned by
+// IntegerPowerRequest.Validate if the designated constraints aren't met.
+type IntegerPowerRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IntegerPowerRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IntegerPowerRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IntegerPowerRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IntegerPowerRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IntegerPowerRequestValidationError) ErrorName() string {
+	return "IntegerPowerRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e IntegerPowerRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIntegerPowerRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IntegerPowerRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IntegerPowerRequestValidationError{}
+
+var _IntegerPowerRequest_BaseRegister_Pattern = regexp.MustCompile("^([A-Za-z_][A-Za-z0-9_]*)?$")
+
+var _IntegerPowerRequest_ExponentRegister_Pattern = regexp.MustCompile("^([A-Za-z_][A-Za-z0-9_]*)?$")
+
+// Validate checks the field values on IntegerPowerResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *IntegerPowerResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IntegerPowerResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// IntegerPowerResponseMultiError, or nil if none found.
+func (m *IntegerPowerResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IntegerPowerResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Result
+
+	if len(errors) > 0 {
+		return IntegerPowerResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// IntegerPowerResponseMultiError is an error wrapping multiple validation
+// errors returned by IntegerPowerResponse.ValidateAll() if the designated
+// constraints aren't met.
+type IntegerPowerResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IntegerPowerResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IntegerPowerResponseMultiError) AllErrors() []error { return m }
+
+// IntegerPowerResponseValidationError is the validation error returned by
+// IntegerPowerResponse.Validate if the designated constraints aren't met.
+type IntegerPowerResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IntegerPowerResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IntegerPowerResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IntegerPowerResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IntegerPowerResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IntegerPowerResponseValidationError) ErrorName() string {
+	return "IntegerPowerResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e IntegerPowerResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIntegerPowerResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IntegerPowerResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IntegerPowerResponseValidationError{}
+
+// Validate checks the field values on PrimeDecomposeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PrimeDecomposeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PrimeDecomposeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PrimeDecomposeRequestMultiError, or nil if none found.
+func (m *PrimeDecomposeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PrimeDecomposeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Number
+
+	if len(errors) > 0 {
+		return PrimeDecomposeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PrimeDecomposeRequestMultiError is an error wrapping multiple validation
+// errors returned by PrimeDecomposeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type PrimeDecomposeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PrimeDecomposeRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PrimeDecomposeRequestMultiError) AllErrors() []error { return m }
+
+// PrimeDecomposeRequestValidationError is the validation error returned by
+// PrimeDecomposeRequest.Validate if the designated constraints aren't met.
+type PrimeDecomposeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PrimeDecomposeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PrimeDecomposeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PrimeDecomposeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PrimeDecomposeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PrimeDecomposeRequestValidationError) ErrorName() string {
+	return "PrimeDecomposeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PrimeDecomposeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPrimeDecomposeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PrimeDecomposeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PrimeDecomposeRequestValidationError{}
+
+// Validate checks the field values on PrimeDecomposeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PrimeDecomposeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PrimeDecomposeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PrimeDecomposeResponseMultiError, or nil if none found.
+func (m *PrimeDecomposeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PrimeDecomposeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Number
+
+	if len(errors) > 0 {
+		return PrimeDecomposeResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PrimeDecomposeResponseMultiError is an error wrapping multiple validation
+// errors returned by PrimeDecomposeResponse.ValidateAll() if the designated
+// constraints aren't met.
+type PrimeDecomposeResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PrimeDecomposeResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PrimeDecomposeResponseMultiError) AllErrors() []error { return m }
+
+// PrimeDecomposeResponseValidationError is the validation error returned by
+// PrimeDecomposeResponse.Validate if the designated constraints aren't met.
+type PrimeDecomposeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PrimeDecomposeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PrimeDecomposeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PrimeDecomposeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PrimeDecomposeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PrimeDecomposeResponseValidationError) ErrorName() string {
+	return "PrimeDecomposeResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PrimeDecomposeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPrimeDecomposeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PrimeDecomposeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PrimeDecomposeResponseValidationError{}
+
+// Validate checks the field values on PrimesInRangeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PrimesInRangeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PrimesInRangeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PrimesInRangeRequestMultiError, or nil if none found.
+func (m *PrimesInRangeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PrimesInRangeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for From
+
+	// no validation rules for To
+
+	if len(errors) > 0 {
+		return PrimesInRangeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PrimesInRangeRequestMultiError is an error wrapping multiple validation
+// errors returned by PrimesInRangeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type PrimesInRangeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PrimesInRangeRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PrimesInRangeRequestMultiError) AllErrors() []error { return m }
+
+// PrimesInRangeRequestValidationError is the validation error returned by
+// PrimesInRangeRequest.Validate if the designated constraints aren't met.
+type PrimesInRangeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PrimesInRangeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PrimesInRangeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PrimesInRangeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PrimesInRangeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PrimesInRangeRequestValidationError) ErrorName() string {
+	return "PrimesInRangeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PrimesInRangeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPrimesInRangeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PrimesInRangeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PrimesInRangeRequestValidationError{}
+
+// Validate checks the field values on PrimesInRangeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PrimesInRangeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PrimesInRangeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PrimesInRangeResponseMultiError, or nil if none found.
+func (m *PrimesInRangeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PrimesInRangeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Number
+
+	if len(errors) > 0 {
+		return PrimesInRangeResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PrimesInRangeResponseMultiError is an error wrapping multiple validation
+// errors returned by PrimesInRangeResponse.ValidateAll() if the designated
+// constraints aren't met.
+type PrimesInRangeResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PrimesInRangeResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PrimesInRangeResponseMultiError) AllErrors() []error { return m }
+
+// PrimesInRangeResponseValidationError is the validation error returned by
+// PrimesInRangeResponse.Validate if the designated constraints aren't met.
+type PrimesInRangeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PrimesInRangeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PrimesInRangeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PrimesInRangeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PrimesInRangeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PrimesInRangeResponseValidationError) ErrorName() string {
+	return "PrimesInRangeResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PrimesInRangeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPrimesInRangeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PrimesInRangeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PrimesInRangeResponseValidationError{}
+
+// Validate checks the field values on IsPrimeRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *IsPrimeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IsPrimeRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in IsPrimeRequestMultiError,
+// or nil if none found.
+func (m *IsPrimeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IsPrimeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Number
+
+	if len(errors) > 0 {
+		return IsPrimeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// IsPrimeRequestMultiError is an error wrapping multiple validation errors
+// returned by IsPrimeRequest.ValidateAll() if the designated constraints
+// aren't met.
+type IsPrimeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IsPrimeRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IsPrimeRequestMultiError) AllErrors() []error { return m }
+
+// IsPrimeRequestValidationError is the validation error returned by
+// IsPrimeRequest.Validate if the designated constraints aren't met.
+type IsPrimeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IsPrimeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IsPrimeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IsPrimeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IsPrimeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IsPrimeRequestValidationError) ErrorName() string { return "IsPrimeRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e IsPrimeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIsPrimeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IsPrimeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IsPrimeRequestValidationError{}
+
+// Validate checks the field values on IsPrimeResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *IsPrimeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IsPrimeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// IsPrimeResponseMultiError, or nil if none found.
+func (m *IsPrimeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IsPrimeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Prime
+
+	if len(errors) > 0 {
+		return IsPrimeResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// IsPrimeResponseMultiError is an error wrapping multiple validation errors
+// returned by IsPrimeResponse.ValidateAll() if the designated constraints
+// aren't met.
+type IsPrimeResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IsPrimeResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IsPrimeResponseMultiError) AllErrors() []error { return m }
+
+// IsPrimeResponseValidationError is the validation error returned by
+// IsPrimeResponse.Validate if the designated constraints aren't met.
+type IsPrimeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IsPrimeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IsPrimeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IsPrimeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IsPrimeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IsPrimeResponseValidationError) ErrorName() string { return "IsPrimeResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e IsPrimeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIsPrimeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IsPrimeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IsPrimeResponseValidationError{}
+
+// Validate checks the field values on GreatestCommonDivisorRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GreatestCommonDivisorRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GreatestCommonDivisorRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GreatestCommonDivisorRequestMultiError, or nil if none found.
+func (m *GreatestCommonDivisorRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GreatestCommonDivisorRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GreatestCommonDivisorRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GreatestCommonDivisorRequestMultiError is an error wrapping multiple
+// validation errors returned by GreatestCommonDivisorRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GreatestCommonDivisorRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GreatestCommonDivisorRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GreatestCommonDivisorRequestMultiError) AllErrors() []error { return m }
+
+// GreatestCommonDivisorRequestValidationError is the validation error returned
+// by GreatestCommonDivisorRequest.Validate if the designated constraints
+// aren't met.
+type GreatestCommonDivisorRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GreatestCommonDivisorRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GreatestCommonDivisorRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GreatestCommonDivisorRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GreatestCommonDivisorRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GreatestCommonDivisorRequestValidationError) ErrorName() string {
+	return "GreatestCommonDivisorRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GreatestCommonDivisorRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGreatestCommonDivisorRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GreatestCommonDivisorRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GreatestCommonDivisorRequestValidationError{}
+
+// Validate checks the field values on GreatestCommonDivisorResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GreatestCommonDivisorResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GreatestCommonDivisorResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GreatestCommonDivisorResponseMultiError, or nil if none found.
+func (m *GreatestCommonDivisorResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GreatestCommonDivisorResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Result
+
+	if len(errors) > 0 {
+		return GreatestCommonDivisorResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GreatestCommonDivisorResponseMultiError is an error wrapping multiple
+// validation errors returned by GreatestCommonDivisorResponse.ValidateAll()
+// if the designated constraints aren't met.
+type GreatestCommonDivisorResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GreatestCommonDivisorResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GreatestCommonDivisorResponseMultiError) AllErrors() []error { return m }
+
+// GreatestCommonDivisorResponseValidationError is the validation error
+// returned by GreatestCommonDivisorResponse.Validate if the designated
+// constraints aren't met.
+type GreatestCommonDivisorResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GreatestCommonDivisorResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GreatestCommonDivisorResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GreatestCommonDivisorResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GreatestCommonDivisorResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GreatestCommonDivisorResponseValidationError) ErrorName() string {
+	return "GreatestCommonDivisorResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GreatestCommonDivisorResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGreatestCommonDivisorResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GreatestCommonDivisorResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GreatestCommonDivisorResponseValidationError{}
+
+// Validate checks the field values on LeastCommonMultipleRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *LeastCommonMultipleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LeastCommonMultipleRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LeastCommonMultipleRequestMultiError, or nil if none found.
+func (m *LeastCommonMultipleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LeastCommonMultipleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return LeastCommonMultipleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// LeastCommonMultipleRequestMultiError is an error wrapping multiple
+// validation errors returned by LeastCommonMultipleRequest.ValidateAll() if
+// the designated constraints aren't met.
+type LeastCommonMultipleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LeastCommonMultipleRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LeastCommonMultipleRequestMultiError) AllErrors() []error { return m }
+
+// LeastCommonMultipleRequestValidationError is the validation error returned
+// by LeastCommonMultipleRequest.Validate if the designated constraints aren't met.
+type LeastCommonMultipleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LeastCommonMultipleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LeastCommonMultipleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LeastCommonMultipleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LeastCommonMultipleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LeastCommonMultipleRequestValidationError) ErrorName() string {
+	return "LeastCommonMultipleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e LeastCommonMultipleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLeastCommonMultipleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LeastCommonMultipleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LeastCommonMultipleRequestValidationError{}
+
+// Validate checks the field values on LeastCommonMultipleResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *LeastCommonMultipleResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LeastCommonMultipleResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LeastCommonMultipleResponseMultiError, or nil if none found.
+func (m *LeastCommonMultipleResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LeastCommonMultipleResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Result
+
+	if len(errors) > 0 {
+		return LeastCommonMultipleResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// LeastCommonMultipleResponseMultiError is an error wrapping multiple
+// validation errors returned by LeastCommonMultipleResponse.ValidateAll() if
+// the designated constraints aren't met.
+type LeastCommonMultipleResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LeastCommonMultipleResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LeastCommonMultipleResponseMultiError) AllErrors() []error { return m }
+
+// LeastCommonMultipleResponseValidationError is the validation error returned
+// by LeastCommonMultipleResponse.Validate if the designated constraints
+// aren't met.
+type LeastCommonMultipleResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LeastCommonMultipleResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LeastCommonMultipleResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LeastCommonMultipleResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LeastCommonMultipleResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LeastCommonMultipleResponseValidationError) ErrorName() string {
+	return "LeastCommonMultipleResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e LeastCommonMultipleResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLeastCommonMultipleResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LeastCommonMultipleResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LeastCommonMultipleResponseValidationError{}
+
+// Validate checks the field values on ModularPowerRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ModularPowerRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ModularPowerRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ModularPowerRequestMultiError, or nil if none found.
+func (m *ModularPowerRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ModularPowerRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Base
+
+	// no validation rules for Exponent
+
+	// no validation rules for Modulus
+
+	if len(errors) > 0 {
+		return ModularPowerRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ModularPowerRequestMultiError is an error wrapping multiple validation
+// errors returned by ModularPowerRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ModularPowerRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ModularPowerRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ModularPowerRequestMultiError) AllErrors() []error { return m }
+
+// ModularPowerRequestValidationError is the validation error returned by
+// ModularPowerRequest.Validate if the designated constraints aren't met.
+type ModularPowerRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ModularPowerRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ModularPowerRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ModularPowerRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ModularPowerRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ModularPowerRequestValidationError) ErrorName() string {
+	return "ModularPowerRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ModularPowerRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sModularPowerRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ModularPowerRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ModularPowerRequestValidationError{}
+
+// Validate checks the field values on ModularPowerResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ModularPowerResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ModularPowerResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ModularPowerResponseMultiError, or nil if none found.
+func (m *ModularPowerResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ModularPowerResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Result
+
+	if len(errors) > 0 {
+		return ModularPowerResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ModularPowerResponseMultiError is an error wrapping multiple validation
+// errors returned by ModularPowerResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ModularPowerResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ModularPowerResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ModularPowerResponseMultiError) AllErrors() []error { return m }
+
+// ModularPowerResponseValidationError is the validation error returned by
+// ModularPowerResponse.Validate if the designated constraints aren't met.
+type ModularPowerResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ModularPowerResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ModularPowerResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ModularPowerResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ModularPowerResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ModularPowerResponseValidationError) ErrorName() string {
+	return "ModularPowerResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ModularPowerResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sModularPowerResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ModularPowerResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ModularPowerResponseValidationError{}
+
+// Validate checks the field values on ModularInverseRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ModularInverseRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ModularInverseRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ModularInverseRequestMultiError, or nil if none found.
+func (m *ModularInverseRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ModularInverseRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Number
+
+	// no validation rules for Modulus
+
+	if len(errors) > 0 {
+		return ModularInverseRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ModularInverseRequestMultiError is an error wrapping multiple validation
+// errors returned by ModularInverseRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ModularInverseRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ModularInverseRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ModularInverseRequestMultiError) AllErrors() []error { return m }
+
+// ModularInverseRequestValidationError is the validation error returned by
+// ModularInverseRequest.Validate if the designated constraints aren't met.
+type ModularInverseRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ModularInverseRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ModularInverseRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ModularInverseRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ModularInverseRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ModularInverseRequestValidationError) ErrorName() string {
+	return "ModularInverseRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ModularInverseRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sModularInverseRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ModularInverseRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ModularInverseRequestValidationError{}
+
+// Validate checks the field values on ModularInverseResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ModularInverseResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ModularInverseResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ModularInverseResponseMultiError, or nil if none found.
+func (m *ModularInverseResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ModularInverseResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Result
+
+	if len(errors) > 0 {
+		return ModularInverseResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ModularInverseResponseMultiError is an error wrapping multiple validation
+// errors returned by ModularInverseResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ModularInverseResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ModularInverseResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ModularInverseResponseMultiError) AllErrors() []error { return m }
+
+// ModularInverseResponseValidationError is the validation error returned by
+// ModularInverseResponse.Validate if the designated constraints aren't met.
+type ModularInverseResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ModularInverseResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ModularInverseResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ModularInverseResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ModularInverseResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ModularInverseResponseValidationError) ErrorName() string {
+	return "ModularInverseResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ModularInverseResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sModularInverseResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ModularInverseResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ModularInverseResponseValidationError{}
+
+// Validate checks the field values on TotientRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TotientRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TotientRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TotientRequestMultiError,
+// or nil if none found.
+func (m *TotientRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TotientRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Number
+
+	if len(errors) > 0 {
+		return TotientRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// TotientRequestMultiError is an error wrapping multiple validation errors
+// returned by TotientRequest.ValidateAll() if the designated constraints
+// aren't met.
+type TotientRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TotientRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TotientRequestMultiError) AllErrors() []error { return m }
+
+// TotientRequestValidationError is the validation error returned by
+// TotientRequest.Validate if the designated constraints aren't met.
+type TotientRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TotientRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TotientRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TotientRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TotientRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TotientRequestValidationError) ErrorName() string { return "TotientRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TotientRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTotientRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TotientRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TotientRequestValidationError{}
+
+// Validate checks the field values on TotientResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TotientResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TotientResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TotientResponseMultiError, or nil if none found.
+func (m *TotientResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TotientResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Result
+
+	if len(errors) > 0 {
+		return TotientResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// TotientResponseMultiError is an error wrapping multiple validation errors
+// returned by TotientResponse.ValidateAll() if the designated constraints
+// aren't met.
+type TotientResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TotientResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TotientResponseMultiError) AllErrors() []error { return m }
+
+// TotientResponseValidationError is the validation error returned by
+// TotientResponse.Validate if the designated constraints aren't met.
+type TotientResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TotientResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TotientResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TotientResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TotientResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TotientResponseValidationError) ErrorName() string { return "TotientResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TotientResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTotientResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TotientResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TotientResponseValidationError{}
+
+// Validate checks the field values on SquareRootRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SquareRootRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SquareRootRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SquareRootRequestMultiError, or nil if none found.
+func (m *SquareRootRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SquareRootRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Number
+
+	if utf8.RuneCountInString(m.GetNumberRegister()) > 64 {
+		err := SquareRootRequestValidationError{
+			field:  "NumberRegister",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_SquareRootRequest_NumberRegister_Pattern.MatchString(m.GetNumberRegister()) {
+		err := SquareRootRequestValidationError{
+			field:  "NumberRegister",
+			reason: "value does not match regex pattern \"^([A-Za-z_][A-Za-z0-9_]*)?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SquareRootRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SquareRootRequestMultiError is an error wrapping multiple validation errors
+// returned by SquareRootRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SquareRootRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SquareRootRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SquareRootRequestMultiError) AllErrors() []error { return m }
+
+// SquareRootRequestValidationError is the validation error returned by
+// SquareRootRequest.Validate if the designated constraints aren't met.
+type SquareRootRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SquareRootRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SquareRootRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SquareRootRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SquareRootRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SquareRootRequestValidationError) ErrorName() string {
+	return "SquareRootRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SquareRootRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSquareRootRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SquareRootRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SquareRootRequestValidationError{}
+
+var _SquareRootRequest_NumberRegister_Pattern = regexp.MustCompile("^([A-Za-z_][A-Za-z0-9_]*)?$")
+
+// Validate checks the field values on SquareRootResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SquareRootResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SquareRootResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SquareRootResponseMultiError, or nil if none found.
+func (m *SquareRootResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SquareRootResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Result
+
+	if len(errors) > 0 {
+		return SquareRootResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SquareRootResponseMultiError is an error wrapping multiple validation errors
+// returned by SquareRootResponse.ValidateAll() if the designated constraints
+// aren't met.
+type SquareRootResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SquareRootResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SquareRootResponseMultiError) AllErrors() []error { return m }
+
+// SquareRootResponseValidationError is the validation error returned by
+// SquareRootResponse.Validate if the designated constraints aren't met.
+type SquareRootResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SquareRootResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SquareRootResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SquareRootResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SquareRootResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SquareRootResponseValidationError) ErrorName() string {
+	return "SquareRootResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SquareRootResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSquareRootResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SquareRootResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SquareRootResponseValidationError{}
+
+// Validate checks the field values on RootRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RootRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RootRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RootRequestMultiError, or
+// nil if none found.
+func (m *RootRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RootRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Number
+
+	if utf8.RuneCountInString(m.GetNumberRegister()) > 64 {
+		err := RootRequestValidationError{
+			field:  "NumberRegister",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_RootRequest_NumberRegister_Pattern.MatchString(m.GetNumberRegister()) {
+		err := RootRequestValidationError{
+			field:  "NumberRegister",
+			reason: "value does not match regex pattern \"^([A-Za-z_][A-Za-z0-9_]*)?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Degree
+
+	// no validation rules for Complex
+
+	if m.GetSignificantDigits() > 17 {
+		err := RootRequestValidationError{
+			field:  "SignificantDigits",
+			reason: "value must be less than or equal to 17",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RootRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RootRequestMultiError is an error wrapping multiple validation errors
+// returned by RootRequest.ValidateAll() if the designated constraints aren't met.
+type RootRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RootRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RootRequestMultiError) AllErrors() []error { return m }
+
+// RootRequestValidationError is the validation error returned by
+// RootRequest.Validate if the designated constraints aren't met.
+type RootRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RootRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RootRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RootRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RootRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RootRequestValidationError) ErrorName() string { return "RootRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RootRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRootRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RootRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RootRequestValidationError{}
+
+var _RootRequest_NumberRegister_Pattern = regexp.MustCompile("^([A-Za-z_][A-Za-z0-9_]*)?$")
+
+// Validate checks the field values on RootResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RootResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RootResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RootResponseMultiError, or
+// nil if none found.
+func (m *RootResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RootResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Real
+
+	// no validation rules for Imaginary
+
+	if len(errors) > 0 {
+		return RootResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RootResponseMultiError is an error wrapping multiple validation errors
+// returned by RootResponse.ValidateAll() if the designated constraints aren't met.
+type RootResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RootResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RootResponseMultiError) AllErrors() []error { return m }
+
+// RootResponseValidationError is the validation error returned by
+// RootResponse.Validate if the designated constraints aren't met.
+type RootResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RootResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RootResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RootResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RootResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RootResponseValidationError) ErrorName() string { return "RootResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RootResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRootResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RootResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RootResponseValidationError{}
+
+// Validate checks the field values on EvaluateRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *EvaluateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EvaluateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EvaluateRequestMultiError, or nil if none found.
+func (m *EvaluateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EvaluateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetExpression()) < 1 {
+		err := EvaluateRequestValidationError{
+			field:  "Expression",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetExpression()) > 1024 {
+		err := EvaluateRequestValidationError{
+			field:  "Expression",
+			reason: "value length must be at most 1024 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	{
+		sorted_keys := make([]string, len(m.GetVariables()))
+		i := 0
+		for key := range m.GetVariables() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetVariables()[key]
+			_ = val
+
+			if !_EvaluateRequest_Variables_Pattern.MatchString(key) {
+				err := EvaluateRequestValidationError{
+					field:  fmt.Sprintf("Variables[%v]", key),
+					reason: "value does not match regex pattern \"^[A-Za-z_][A-Za-z0-9_]*$\"",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+			// no validation rules for Variables[key]
+		}
+	}
+
+	if len(errors) > 0 {
+		return EvaluateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// EvaluateRequestMultiError is an error wrapping multiple validation errors
+// returned by EvaluateRequest.ValidateAll() if the designated constraints
+// aren't met.
+type EvaluateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EvaluateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EvaluateRequestMultiError) AllErrors() []error { return m }
+
+// EvaluateRequestValidationError is the validation error returned by
+// EvaluateRequest.Validate if the designated constraints aren't met.
+type EvaluateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EvaluateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EvaluateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EvaluateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EvaluateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EvaluateRequestValidationError) ErrorName() string { return "EvaluateRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e EvaluateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEvaluateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EvaluateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EvaluateRequestValidationError{}
+
+var _EvaluateRequest_Variables_Pattern = regexp.MustCompile("^[A-Za-z_][A-Za-z0-9_]*$")
+
+// Validate checks the field values on EvaluateResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *EvaluateResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EvaluateResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EvaluateResponseMultiError, or nil if none found.
+func (m *EvaluateResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EvaluateResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Result
+
+	if len(errors) > 0 {
+		return EvaluateResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// EvaluateResponseMultiError is an error wrapping multiple validation errors
+// returned by EvaluateResponse.ValidateAll() if the designated constraints
+// aren't met.
+type EvaluateResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EvaluateResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EvaluateResponseMultiError) AllErrors() []error { return m }
+
+// EvaluateResponseValidationError is the validation error returned by
+// EvaluateResponse.Validate if the designated constraints aren't met.
+type EvaluateResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EvaluateResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EvaluateResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EvaluateResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EvaluateResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EvaluateResponseValidationError) ErrorName() string { return "EvaluateResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e EvaluateResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEvaluateResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EvaluateResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EvaluateResponseValidationError{}
+
+// Validate checks the field values on BatchOperation with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BatchOperation) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchOperation with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BatchOperationMultiError,
+// or nil if none found.
+func (m *BatchOperation) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchOperation) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	oneofOperationPresent := false
+	switch v := m.Operation.(type) {
+	case *BatchOperation_Sum:
+		if v == nil {
+			err := BatchOperationValidationError{
+				field:  "Operation",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofOperationPresent = true
+
+		if all {
+			switch v := interface{}(m.GetSum()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchOperationValidationError{
+						field:  "Sum",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchOperationValidationError{
+						field:  "Sum",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSum()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchOperationValidationError{
+					field:  "Sum",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *BatchOperation_Subtract:
+		if v == nil {
+			err := BatchOperationValidationError{
+				field:  "Operation",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofOperationPresent = true
+
+		if all {
+			switch v := interface{}(m.GetSubtract()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchOperationValidationError{
+						field:  "Subtract",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchOperationValidationError{
+						field:  "Subtract",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSubtract()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchOperationValidationError{
+					field:  "Subtract",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *BatchOperation_Multiply:
+		if v == nil {
+			err := BatchOperationValidationError{
+				field:  "Operation",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofOperationPresent = true
+
+		if all {
+			switch v := interface{}(m.GetMultiply()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchOperationValidationError{
+						field:  "Multiply",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchOperationValidationError{
+						field:  "Multiply",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetMultiply()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchOperationValidationError{
+					field:  "Multiply",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *BatchOperation_Divide:
+		if v == nil {
+			err := BatchOperationValidationError{
+				field:  "Operation",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofOperationPresent = true
+
+		if all {
+			switch v := interface{}(m.GetDivide()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchOperationValidationError{
+						field:  "Divide",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchOperationValidationError{
+						field:  "Divide",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetDivide()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchOperationValidationError{
+					field:  "Divide",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *BatchOperation_Modulo:
+		if v == nil {
+			err := BatchOperationValidationError{
+				field:  "Operation",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofOperationPresent = true
+
+		if all {
+			switch v := interface{}(m.GetModulo()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchOperationValidationError{
+						field:  "Modulo",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchOperationValidationError{
+						field:  "Modulo",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetModulo()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchOperationValidationError{
+					field:  "Modulo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *BatchOperation_IntegerPower:
+		if v == nil {
+			err := BatchOperationValidationError{
+				field:  "Operation",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofOperationPresent = true
+
+		if all {
+			switch v := interface{}(m.GetIntegerPower()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchOperationValidationError{
+						field:  "IntegerPower",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchOperationValidationError{
+						field:  "IntegerPower",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetIntegerPower()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchOperationValidationError{
+					field:  "IntegerPower",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *BatchOperation_SquareRoot:
+		if v == nil {
+			err := BatchOperationValidationError{
+				field:  "Operation",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofOperationPresent = true
+
+		if all {
+			switch v := interface{}(m.GetSquareRoot()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchOperationValidationError{
+						field:  "SquareRoot",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchOperationValidationError{
+						field:  "SquareRoot",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSquareRoot()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchOperationValidationError{
+					field:  "SquareRoot",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *BatchOperation_Root:
+		if v == nil {
+			err := BatchOperationValidationError{
+				field:  "Operation",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofOperationPresent = true
+
+		if all {
+			switch v := interface{}(m.GetRoot()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchOperationValidationError{
+						field:  "Root",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchOperationValidationError{
+						field:  "Root",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetRoot()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchOperationValidationError{
+					field:  "Root",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *BatchOperation_PrimeDecompose:
+		if v == nil {
+			err := BatchOperationValidationError{
+				field:  "Operation",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofOperationPresent = true
+
+		if all {
+			switch v := interface{}(m.GetPrimeDecompose()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchOperationValidationError{
+						field:  "PrimeDecompose",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchOperationValidationError{
+						field:  "PrimeDecompose",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetPrimeDecompose()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchOperationValidationError{
+					field:  "PrimeDecompose",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *BatchOperation_Evaluate:
+		if v == nil {
+			err := BatchOperationValidationError{
+				field:  "Operation",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofOperationPresent = true
+
+		if all {
+			switch v := interface{}(m.GetEvaluate()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchOperationValidationError{
+						field:  "Evaluate",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchOperationValidationError{
+						field:  "Evaluate",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetEvaluate()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchOperationValidationError{
+					field:  "Evaluate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+	if !oneofOperationPresent {
+		err := BatchOperationValidationError{
+			field:  "Operation",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return BatchOperationMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchOperationMultiError is an error wrapping multiple validation errors
+// returned by BatchOperation.ValidateAll() if the designated constraints
+// aren't met.
+type BatchOperationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchOperationMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchOperationMultiError) AllErrors() []error { return m }
+
+// BatchOperationValidationError is the validation error returned by
+// BatchOperation.Validate if the designated constraints aren't met.
+type BatchOperationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchOperationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchOperationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchOperationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchOperationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchOperationValidationError) ErrorName() string { return "BatchOperationValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BatchOperationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchOperation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchOperationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchOperationValidationError{}
+
+// Validate checks the field values on BatchCalculateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchCalculateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchCalculateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchCalculateRequestMultiError, or nil if none found.
+func (m *BatchCalculateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchCalculateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetOperations()) > 1000 {
+		err := BatchCalculateRequestValidationError{
+			field:  "Operations",
+			reason: "value must contain no more than 1000 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetOperations() {
+		_, _ = idx, item
+
+		// skipping validation for operations
+
+	}
+
+	// no validation rules for FailFast
+
+	if len(errors) > 0 {
+		return BatchCalculateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchCalculateRequestMultiError is an error wrapping multiple validation
+// errors returned by BatchCalculateRequest.ValidateAll() if the designated
+// constraints aren't met.
+type BatchCalculateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchCalculateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchCalculateRequestMultiError) AllErrors() []error { return m }
+
+// BatchCalculateRequestValidationError is the validation error returned by
+// BatchCalculateRequest.Validate if the designated constraints aren't met.
+type BatchCalculateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchCalculateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchCalculateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchCalculateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchCalculateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchCalculateRequestValidationError) ErrorName() string {
+	return "BatchCalculateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchCalculateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchCalculateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchCalculateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchCalculateRequestValidationError{}
+
+// Validate checks the field values on PrimeFactors with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PrimeFactors) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PrimeFactors with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PrimeFactorsMultiError, or
+// nil if none found.
+func (m *PrimeFactors) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PrimeFactors) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return PrimeFactorsMultiError(errors)
+	}
+
+	return nil
+}
+
+// PrimeFactorsMultiError is an error wrapping multiple validation errors
+// returned by PrimeFactors.ValidateAll() if the designated constraints aren't met.
+type PrimeFactorsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PrimeFactorsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PrimeFactorsMultiError) AllErrors() []error { return m }
+
+// PrimeFactorsValidationError is the validation error returned by
+// PrimeFactors.Validate if the designated constraints aren't met.
+type PrimeFactorsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PrimeFactorsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PrimeFactorsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PrimeFactorsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PrimeFactorsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PrimeFactorsValidationError) ErrorName() string { return "PrimeFactorsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PrimeFactorsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPrimeFactors.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PrimeFactorsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PrimeFactorsValidationError{}
+
+// Validate checks the field values on BatchResult with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BatchResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchResult with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BatchResultMultiError, or
+// nil if none found.
+func (m *BatchResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	switch v := m.Result.(type) {
+	case *BatchResult_Sum:
+		if v == nil {
+			err := BatchResultValidationError{
+				field:  "Result",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetSum()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchResultValidationError{
+						field:  "Sum",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchResultValidationError{
+						field:  "Sum",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSum()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchResultValidationError{
+					field:  "Sum",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *BatchResult_Subtract:
+		if v == nil {
+			err := BatchResultValidationError{
+				field:  "Result",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetSubtract()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchResultValidationError{
+						field:  "Subtract",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchResultValidationError{
+						field:  "Subtract",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSubtract()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchResultValidationError{
+					field:  "Subtract",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *BatchResult_Multiply:
+		if v == nil {
+			err := BatchResultValidationError{
+				field:  "Result",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetMultiply()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchResultValidationError{
+						field:  "Multiply",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchResultValidationError{
+						field:  "Multiply",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetMultiply()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchResultValidationError{
+					field:  "Multiply",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *BatchResult_Divide:
+		if v == nil {
+			err := BatchResultValidationError{
+				field:  "Result",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetDivide()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchResultValidationError{
+						field:  "Divide",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchResultValidationError{
+						field:  "Divide",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetDivide()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchResultValidationError{
+					field:  "Divide",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *BatchResult_Modulo:
+		if v == nil {
+			err := BatchResultValidationError{
+				field:  "Result",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetModulo()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchResultValidationError{
+						field:  "Modulo",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchResultValidationError{
+						field:  "Modulo",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetModulo()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchResultValidationError{
+					field:  "Modulo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *BatchResult_IntegerPower:
+		if v == nil {
+			err := BatchResultValidationError{
+				field:  "Result",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetIntegerPower()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchResultValidationError{
+						field:  "IntegerPower",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchResultValidationError{
+						field:  "IntegerPower",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetIntegerPower()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchResultValidationError{
+					field:  "IntegerPower",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *BatchResult_SquareRoot:
+		if v == nil {
+			err := BatchResultValidationError{
+				field:  "Result",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetSquareRoot()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchResultValidationError{
+						field:  "SquareRoot",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchResultValidationError{
+						field:  "SquareRoot",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSquareRoot()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchResultValidationError{
+					field:  "SquareRoot",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *BatchResult_Root:
+		if v == nil {
+			err := BatchResultValidationError{
+				field:  "Result",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetRoot()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchResultValidationError{
+						field:  "Root",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchResultValidationError{
+						field:  "Root",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetRoot()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchResultValidationError{
+					field:  "Root",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *BatchResult_PrimeDecompose:
+		if v == nil {
+			err := BatchResultValidationError{
+				field:  "Result",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetPrimeDecompose()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchResultValidationError{
+						field:  "PrimeDecompose",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchResultValidationError{
+						field:  "PrimeDecompose",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetPrimeDecompose()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchResultValidationError{
+					field:  "PrimeDecompose",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *BatchResult_Evaluate:
+		if v == nil {
+			err := BatchResultValidationError{
+				field:  "Result",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetEvaluate()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchResultValidationError{
+						field:  "Evaluate",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchResultValidationError{
+						field:  "Evaluate",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetEvaluate()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchResultValidationError{
+					field:  "Evaluate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *BatchResult_Error:
+		if v == nil {
+			err := BatchResultValidationError{
+				field:  "Result",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetError()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchResultValidationError{
+						field:  "Error",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchResultValidationError{
+						field:  "Error",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetError()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchResultValidationError{
+					field:  "Error",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return BatchResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchResultMultiError is an error wrapping multiple validation errors
+// returned by BatchResult.ValidateAll() if the designated constraints aren't met.
+type BatchResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchResultMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchResultMultiError) AllErrors() []error { return m }
+
+// BatchResultValidationError is the validation error returned by
+// BatchResult.Validate if the designated constraints aren't met.
+type BatchResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchResultValidationError) ErrorName() string { return "BatchResultValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BatchResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchResultValidationError{}
+
+// Validate checks the field values on BatchCalculateResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchCalculateResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchCalculateResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchCalculateResponseMultiError, or nil if none found.
+func (m *BatchCalculateResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchCalculateResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchCalculateResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchCalculateResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchCalculateResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BatchCalculateResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchCalculateResponseMultiError is an error wrapping multiple validation
+// errors returned by BatchCalculateResponse.ValidateAll() if the designated
+// constraints aren't met.
+type BatchCalculateResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchCalculateResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchCalculateResponseMultiError) AllErrors() []error { return m }
+
+// BatchCalculateResponseValidationError is the validation error returned by
+// BatchCalculateResponse.Validate if the designated constraints aren't met.
+type BatchCalculateResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchCalculateResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchCalculateResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchCalculateResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchCalculateResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchCalculateResponseValidationError) ErrorName() string {
+	return "BatchCalculateResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchCalculateResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchCalculateResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchCalculateResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchCalculateResponseValidationError{}
+
+// Validate checks the field values on StoreMemoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StoreMemoryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StoreMemoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StoreMemoryRequestMultiError, or nil if none found.
+func (m *StoreMemoryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StoreMemoryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetName()) > 64 {
+		err := StoreMemoryRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_StoreMemoryRequest_Name_Pattern.MatchString(m.GetName()) {
+		err := StoreMemoryRequestValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[A-Za-z_][A-Za-z0-9_]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Value
+
+	if len(errors) > 0 {
+		return StoreMemoryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// StoreMemoryRequestMultiError is an error wrapping multiple validation errors
+// returned by StoreMemoryRequest.ValidateAll() if the designated constraints
+// aren't met.
+type StoreMemoryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StoreMemoryRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StoreMemoryRequestMultiError) AllErrors() []error { return m }
+
+// StoreMemoryRequestValidationError is the validation error returned by
+// StoreMemoryRequest.Validate if the designated constraints aren't met.
+type StoreMemoryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StoreMemoryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StoreMemoryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StoreMemoryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StoreMemoryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StoreMemoryRequestValidationError) ErrorName() string {
+	return "StoreMemoryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StoreMemoryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStoreMemoryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StoreMemoryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StoreMemoryRequestValidationError{}
+
+var _StoreMemoryRequest_Name_Pattern = regexp.MustCompile("^[A-Za-z_][A-Za-z0-9_]*$")
+
+// Validate checks the field values on StoreMemoryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StoreMemoryResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StoreMemoryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StoreMemoryResponseMultiError, or nil if none found.
+func (m *StoreMemoryResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StoreMemoryResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return StoreMemoryResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// StoreMemoryResponseMultiError is an error wrapping multiple validation
+// errors returned by StoreMemoryResponse.ValidateAll() if the designated
+// constraints aren't met.
+type StoreMemoryResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StoreMemoryResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StoreMemoryResponseMultiError) AllErrors() []error { return m }
+
+// StoreMemoryResponseValidationError is the validation error returned by
+// StoreMemoryResponse.Validate if the designated constraints aren't met.
+type StoreMemoryResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StoreMemoryResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StoreMemoryResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StoreMemoryResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StoreMemoryResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StoreMemoryResponseValidationError) ErrorName() string {
+	return "StoreMemoryResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StoreMemoryResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStoreMemoryResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StoreMemoryResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StoreMemoryResponseValidationError{}
+
+// Validate checks the field values on RecallMemoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RecallMemoryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RecallMemoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RecallMemoryRequestMultiError, or nil if none found.
+func (m *RecallMemoryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RecallMemoryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetName()) > 64 {
+		err := RecallMemoryRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_RecallMemoryRequest_Name_Pattern.MatchString(m.GetName()) {
+		err := RecallMemoryRequestValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[A-Za-z_][A-Za-z0-9_]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RecallMemoryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RecallMemoryRequestMultiError is an error wrapping multiple validation
+// errors returned by RecallMemoryRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RecallMemoryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RecallMemoryRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RecallMemoryRequestMultiError) AllErrors() []error { return m }
+
+// RecallMemoryRequestValidationError is the validation error returned by
+// RecallMemoryRequest.Validate if the designated constraints aren't met.
+type RecallMemoryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RecallMemoryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RecallMemoryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RecallMemoryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RecallMemoryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RecallMemoryRequestValidationError) ErrorName() string {
+	return "RecallMemoryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RecallMemoryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRecallMemoryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RecallMemoryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RecallMemoryRequestValidationError{}
+
+var _RecallMemoryRequest_Name_Pattern = regexp.MustCompile("^[A-Za-z_][A-Za-z0-9_]*$")
+
+// Validate checks the field values on RecallMemoryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RecallMemoryResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RecallMemoryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RecallMemoryResponseMultiError, or nil if none found.
+func (m *RecallMemoryResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RecallMemoryResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Value
+
+	if len(errors) > 0 {
+		return RecallMemoryResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RecallMemoryResponseMultiError is an error wrapping multiple validation
+// errors returned by RecallMemoryResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RecallMemoryResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RecallMemoryResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RecallMemoryResponseMultiError) AllErrors() []error { return m }
+
+// RecallMemoryResponseValidationError is the validation error returned by
+// RecallMemoryResponse.Validate if the designated constraints aren't met.
+type RecallMemoryResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RecallMemoryResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RecallMemoryResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RecallMemoryResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RecallMemoryResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RecallMemoryResponseValidationError) ErrorName() string {
+	return "RecallMemoryResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RecallMemoryResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRecallMemoryResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RecallMemoryResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RecallMemoryResponseValidationError{}
+
+// Validate checks the field values on ClearMemoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ClearMemoryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ClearMemoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ClearMemoryRequestMultiError, or nil if none found.
+func (m *ClearMemoryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ClearMemoryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetName()) > 64 {
+		err := ClearMemoryRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_ClearMemoryRequest_Name_Pattern.MatchString(m.GetName()) {
+		err := ClearMemoryRequestValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^([A-Za-z_][A-Za-z0-9_]*)?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ClearMemoryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ClearMemoryRequestMultiError is an error wrapping multiple validation errors
+// returned by ClearMemoryRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ClearMemoryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ClearMemoryRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ClearMemoryRequestMultiError) AllErrors() []error { return m }
+
+// ClearMemoryRequestValidationError is the validation error returned by
+// ClearMemoryRequest.Validate if the designated constraints aren't met.
+type ClearMemoryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ClearMemoryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ClearMemoryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ClearMemoryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ClearMemoryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ClearMemoryRequestValidationError) ErrorName() string {
+	return "ClearMemoryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ClearMemoryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sClearMemoryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ClearMemoryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ClearMemoryRequestValidationError{}
+
+var _ClearMemoryRequest_Name_Pattern = regexp.MustCompile("^([A-Za-z_][A-Za-z0-9_]*)?$")
+
+// Validate checks the field values on ClearMemoryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ClearMemoryResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ClearMemoryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ClearMemoryResponseMultiError, or nil if none found.
+func (m *ClearMemoryResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ClearMemoryResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ClearMemoryResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ClearMemoryResponseMultiError is an error wrapping multiple validation
+// errors returned by ClearMemoryResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ClearMemoryResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ClearMemoryResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ClearMemoryResponseMultiError) AllErrors() []error { return m }
+
+// ClearMemoryResponseValidationError is the validation error returned by
+// ClearMemoryResponse.Validate if the designated constraints aren't met.
+type ClearMemoryResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ClearMemoryResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ClearMemoryResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ClearMemoryResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ClearMemoryResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ClearMemoryResponseValidationError) ErrorName() string {
+	return "ClearMemoryResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ClearMemoryResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sClearMemoryResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ClearMemoryResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ClearMemoryResponseValidationError{}
+
+// Validate checks the field values on ListHistoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListHistoryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListHistoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListHistoryRequestMultiError, or nil if none found.
+func (m *ListHistoryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListHistoryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Limit
+
+	if len(errors) > 0 {
+		return ListHistoryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListHistoryRequestMultiError is an error wrapping multiple validation errors
+// returned by ListHistoryRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListHistoryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListHistoryRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListHistoryRequestMultiError) AllErrors() []error { return m }
+
+// ListHistoryRequestValidationError is the validation error returned by
+// ListHistoryRequest.Validate if the designated constraints aren't met.
+type ListHistoryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListHistoryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListHistoryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListHistoryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListHistoryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListHistoryRequestValidationError) ErrorName() string {
+	return "ListHistoryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListHistoryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListHistoryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListHistoryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListHistoryRequestValidationError{}
+
+// Validate checks the field values on HistoryEntry with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *HistoryEntry) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on HistoryEntry with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in HistoryEntryMultiError, or
+// nil if none found.
+func (m *HistoryEntry) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *HistoryEntry) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, HistoryEntryValidationError{
+					field:  "Time",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, HistoryEntryValidationError{
+					field:  "Time",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return HistoryEntryValidationError{
+				field:  "Time",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Method
+
+	if all {
+		switch v := interface{}(m.GetRequest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, HistoryEntryValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, HistoryEntryValidationError{
+					field:  "Request",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRequest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return HistoryEntryValidationError{
+				field:  "Request",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetResponse()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, HistoryEntryValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, HistoryEntryValidationError{
+					field:  "Response",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResponse()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return HistoryEntryValidationError{
+				field:  "Response",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetError()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, HistoryEntryValidationError{
+					field:  "Error",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, HistoryEntryValidationError{
+					field:  "Error",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetError()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return HistoryEntryValidationError{
+				field:  "Error",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for RequestSize
+
+	// no validation rules for ResponseSize
+
+	if len(errors) > 0 {
+		return HistoryEntryMultiError(errors)
+	}
+
+	return nil
+}
+
+// HistoryEntryMultiError is an error wrapping multiple validation errors
+// returned by HistoryEntry.ValidateAll() if the designated constraints aren't met.
+type HistoryEntryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HistoryEntryMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HistoryEntryMultiError) AllErrors() []error { return m }
+
+// HistoryEntryValidationError is the validation error returned by
+// HistoryEntry.Validate if the designated constraints aren't met.
+type HistoryEntryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HistoryEntryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HistoryEntryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HistoryEntryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HistoryEntryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HistoryEntryValidationError) ErrorName() string { return "HistoryEntryValidationError" }
+
+// Error satisfies the builtin error interface
+func (e HistoryEntryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHistoryEntry.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HistoryEntryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HistoryEntryValidationError{}
+
+// Validate checks the field values on ListHistoryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListHistoryResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListHistoryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListHistoryResponseMultiError, or nil if none found.
+func (m *ListHistoryResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListHistoryResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetEntries() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListHistoryResponseValidationError{
+						field:  fmt.Sprintf("Entries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListHistoryResponseValidationError{
+						field:  fmt.Sprintf("Entries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListHistoryResponseValidationError{
+					field:  fmt.Sprintf("Entries[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListHistoryResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListHistoryResponseMultiError is an error wrapping multiple validation
+// errors returned by ListHistoryResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListHistoryResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListHistoryResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListHistoryResponseMultiError) AllErrors() []error { return m }
+
+// ListHistoryResponseValidationError is the validation error returned by
+// ListHistoryResponse.Validate if the designated constraints aren't met.
+type ListHistoryResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListHistoryResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListHistoryResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListHistoryResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListHistoryResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListHistoryResponseValidationError) ErrorName() string {
+	return "ListHistoryResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListHistoryResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListHistoryResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListHistoryResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListHistoryResponseValidationError{}
+
+// Validate checks the field values on BigOptions with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BigOptions) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BigOptions with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BigOptionsMultiError, or
+// nil if none found.
+func (m *BigOptions) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BigOptions) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := BigOptions_Kind_name[int32(m.GetKind())]; !ok {
+		err := BigOptionsValidationError{
+			field:  "Kind",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPrecision() > 65536 {
+		err := BigOptionsValidationError{
+			field:  "Precision",
+			reason: "value must be less than or equal to 65536",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := BigOptions_RoundingMode_name[int32(m.GetRounding())]; !ok {
+		err := BigOptionsValidationError{
+			field:  "Rounding",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return BigOptionsMultiError(errors)
+	}
+
+	return nil
+}
+
+// BigOptionsMultiError is an error wrapping multiple validation errors
+// returned by BigOptions.ValidateAll() if the designated constraints aren't met.
+type BigOptionsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BigOptionsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BigOptionsMultiError) AllErrors() []error { return m }
+
+// BigOptionsValidationError is the validation error returned by
+// BigOptions.Validate if the designated constraints aren't met.
+type BigOptionsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BigOptionsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BigOptionsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BigOptionsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BigOptionsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BigOptionsValidationError) ErrorName() string { return "BigOptionsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BigOptionsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBigOptions.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BigOptionsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BigOptionsValidationError{}
+
+// Validate checks the field values on BigRequest with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BigRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BigRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BigRequestMultiError, or
+// nil if none found.
+func (m *BigRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BigRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetX()) > 327680 {
+		err := BigRequestValidationError{
+			field:  "X",
+			reason: "value length must be at most 327680 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetXRegister()) > 64 {
+		err := BigRequestValidationError{
+			field:  "XRegister",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_BigRequest_XRegister_Pattern.MatchString(m.GetXRegister()) {
+		err := BigRequestValidationError{
+			field:  "XRegister",
+			reason: "value does not match regex pattern \"^([A-Za-z_][A-Za-z0-9_]*)?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetY()) > 327680 {
+		err := BigRequestValidationError{
+			field:  "Y",
+			reason: "value length must be at most 327680 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetYRegister()) > 64 {
+		err := BigRequestValidationError{
+			field:  "YRegister",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_BigRequest_YRegister_Pattern.MatchString(m.GetYRegister()) {
+		err := BigRequestValidationError{
+			field:  "YRegister",
+			reason: "value does not match regex pattern \"^([A-Za-z_][A-Za-z0-9_]*)?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetOptions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BigRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BigRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BigRequestValidationError{
+				field:  "Options",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return BigRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BigRequestMultiError is an error wrapping multiple validation errors
+// returned by BigRequest.ValidateAll() if the designated constraints aren't met.
+type BigRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BigRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BigRequestMultiError) AllErrors() []error { return m }
+
+// BigRequestValidationError is the validation error returned by
+// BigRequest.Validate if the designated constraints aren't met.
+type BigRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BigRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BigRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BigRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BigRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BigRequestValidationError) ErrorName() string { return "BigRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BigRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBigRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BigRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BigRequestValidationError{}
+
+var _BigRequest_XRegister_Pattern = regexp.MustCompile("^([A-Za-z_][A-Za-z0-9_]*)?$")
+
+var _BigRequest_YRegister_Pattern = regexp.MustCompile("^([A-Za-z_][A-Za-z0-9_]*)?$")
+
+// Validate checks the field values on BigResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BigResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BigResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BigResponseMultiError, or
+// nil if none found.
+func (m *BigResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BigResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Result
+
+	// no validation rules for Remainder
+
+	// no validation rules for Exact
+
+	if len(errors) > 0 {
+		return BigResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BigResponseMultiError is an error wrapping multiple validation errors
+// returned by BigResponse.ValidateAll() if the designated constraints aren't met.
+type BigResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BigResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BigResponseMultiError) AllErrors() []error { return m }
+
+// BigResponseValidationError is the validation error returned by
+// BigResponse.Validate if the designated constraints aren't met.
+type BigResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BigResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BigResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BigResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BigResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BigResponseValidationError) ErrorName() string { return "BigResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BigResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBigResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BigResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BigResponseValidationError{}
@@ -0,0 +1,200 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/grpc/codes"
+
+	calcpb "grpc-course/calc/calc_proto"
+	"grpc-course/calc/stats"
+	"grpc-course/common/rpcerr"
+)
+
+// minEmitInterval bounds the rate of PERIODIC emissions.
+const minEmitInterval = 10 * time.Millisecond
+
+// aggregator is the state of an Aggregate stream.
+type aggregator struct {
+	opts     *calcpb.AggregateOptions
+	interval time.Duration
+	window   *stats.Window
+
+	// last is the last response sent, nil before the first one.
+	last *calcpb.AggregateResponse
+}
+
+func newAggregator(opts *calcpb.AggregateOptions) (*aggregator, error) {
+	if opts == nil {
+		return nil, invalidArgument(reasonInvalidOption, "options", "The first message must hold the options")
+	}
+	if _, ok := calcpb.AggregateOptions_Aggregation_name[int32(opts.Aggregation)]; !ok {
+		return nil, invalidArgument(reasonInvalidOption, "options.aggregation", fmt.Sprintf("Unknown aggregation %v", opts.Aggregation))
+	}
+	a := &aggregator{opts: opts}
+
+	switch {
+	case opts.WindowSize > 0 && opts.WindowDuration != nil:
+		return nil, invalidArgument(reasonInvalidOption, "options.window_duration", "Only one of window_size and window_duration may be set")
+	case opts.WindowSize > 0:
+		// window_size is bounded by MaxWindowLen by its rule in calc.proto.
+		a.window = stats.NewCountWindow(int(opts.WindowSize))
+	case opts.WindowDuration != nil:
+		span, err := ptypes.Duration(opts.WindowDuration)
+		if err != nil || span <= 0 {
+			return nil, invalidArgument(reasonInvalidOption, "options.window_duration", fmt.Sprintf("Invalid window duration %v", opts.WindowDuration))
+		}
+		a.window = stats.NewTimeWindow(span)
+	default:
+		return nil, invalidArgument(reasonInvalidOption, "options.window_size", "One of window_size and window_duration must be set")
+	}
+
+	switch opts.Emission {
+	case calcpb.AggregateOptions_ON_CHANGE, calcpb.AggregateOptions_EVERY_MESSAGE:
+	case calcpb.AggregateOptions_PERIODIC:
+		interval, err := ptypes.Duration(opts.EmitInterval)
+		if err != nil || interval < minEmitInterval {
+			return nil, invalidArgument(reasonInvalidOption, "options.emit_interval", fmt.Sprintf("PERIODIC emission requires an emit interval of at least %v", minEmitInterval))
+		}
+		a.interval = interval
+	default:
+		return nil, invalidArgument(reasonInvalidOption, "options.emission", fmt.Sprintf("Unknown emission %v", opts.Emission))
+	}
+	return a, nil
+}
+
+// current returns the aggregate of the window.
+func (a *aggregator) current() *calcpb.AggregateResponse {
+	res := &calcpb.AggregateResponse{Count: uint32(a.window.Len())}
+	switch a.opts.Aggregation {
+	case calcpb.AggregateOptions_MAX:
+		res.Value = a.window.Max()
+	case calcpb.AggregateOptions_MIN:
+		res.Value = a.window.Min()
+	case calcpb.AggregateOptions_SUM:
+		res.Value = a.window.Sum()
+	case calcpb.AggregateOptions_MEAN:
+		res.Value = a.window.Mean()
+	}
+	return res
+}
+
+// changed returns the aggregate if it changed since the last response.
+func (a *aggregator) changed() (*calcpb.AggregateResponse, bool) {
+	res := a.current()
+	if a.last != nil && a.last.Value == res.Value && (a.last.Count == 0) == (res.Count == 0) {
+		return nil, false
+	}
+	return res, true
+}
+
+func (*server) Aggregate(stream calcpb.Calculator_AggregateServer) error {
+	ctx := stream.Context()
+	first, err := stream.Recv()
+	if err == io.EOF {
+		return nil
+	}
+	if err != nil {
+		return rpcerr.FromStream(ctx, "reading options", err)
+	}
+	a, err := newAggregator(first.GetOptions())
+	if err != nil {
+		return err
+	}
+
+	// Receive in the background so the periodic and expiry emissions are
+	// not blocked by a quiet client.
+	reqs := make(chan *calcpb.AggregateRequest)
+	recvErr := make(chan error, 1)
+	go func() {
+		for {
+			req, err := stream.Recv()
+			if err != nil {
+				recvErr <- err
+				return
+			}
+			select {
+			case reqs <- req:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	var tick <-chan time.Time
+	if a.interval > 0 {
+		ticker := time.NewTicker(a.interval)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+	expiry := time.NewTimer(time.Hour)
+	expiry.Stop()
+	defer expiry.Stop()
+
+	send := func(res *calcpb.AggregateResponse) error {
+		a.last = res
+		if err := stream.Send(res); err != nil {
+			return rpcerr.FromStream(ctx, "sending aggregate", err)
+		}
+		return nil
+	}
+
+	for {
+		var expire <-chan time.Time
+		if next, ok := a.window.NextExpiry(); ok && a.opts.Emission == calcpb.AggregateOptions_ON_CHANGE {
+			expiry.Reset(time.Until(next))
+			expire = expiry.C
+		}
+
+		select {
+		case req := <-reqs:
+			if req.GetOptions() != nil {
+				return invalidArgument(reasonInvalidOption, "options", "Options may only be sent in the first message")
+			}
+			n := req.GetNumber()
+			if math.IsNaN(n) || math.IsInf(n, 0) {
+				return invalidArgument(reasonNonFiniteNumber, "number", fmt.Sprintf("Received non-finite number %v", n))
+			}
+			if err := a.window.Add(time.Now(), n); err == stats.ErrWindowFull {
+				return rpcerr.New(codes.ResourceExhausted, errorDomain, reasonWindowFull,
+					fmt.Sprintf("The window already holds the maximum of %d numbers", stats.MaxWindowLen),
+					map[string]string{"max_numbers": fmt.Sprint(stats.MaxWindowLen)})
+			}
+			switch a.opts.Emission {
+			case calcpb.AggregateOptions_EVERY_MESSAGE:
+				err = send(a.current())
+			case calcpb.AggregateOptions_ON_CHANGE:
+				if res, ok := a.changed(); ok {
+					err = send(res)
+				}
+			}
+		case now := <-tick:
+			a.window.Expire(now)
+			err = send(a.current())
+		case now := <-expire:
+			a.window.Expire(now)
+			if res, ok := a.changed(); ok {
+				err = send(res)
+			}
+		case err := <-recvErr:
+			if err == io.EOF {
+				return nil
+			}
+			return rpcerr.FromStream(ctx, "reading number", err)
+		case <-ctx.Done():
+			return rpcerr.FromStream(ctx, "reading number", ctx.Err())
+		}
+		if err != nil {
+			return err
+		}
+		if expire != nil && !expiry.Stop() {
+			select {
+			case <-expiry.C:
+			default:
+			}
+		}
+	}
+}
@@ -0,0 +1,178 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	calcpb "grpc-course/calc/calc_proto"
+	"grpc-course/common/ratelimit"
+	"grpc-course/common/recovery"
+)
+
+// maxBatchSize is the largest number of operations of a BatchCalculate
+// request.
+const maxBatchSize = 1000
+
+// batchWorkers is the number of operations of a batch run concurrently.
+var batchWorkers = runtime.NumCPU()
+
+// batchMethod returns the full name of the method an operation stands for,
+// whose scopes the caller needs.
+func batchMethod(op *calcpb.BatchOperation) string {
+	switch op.GetOperation().(type) {
+	case *calcpb.BatchOperation_Sum:
+		return "/calc.Calculator/CalculateSum"
+	case *calcpb.BatchOperation_Subtract:
+		return "/calc.Calculator/Subtract"
+	case *calcpb.BatchOperation_Multiply:
+		return "/calc.Calculator/Multiply"
+	case *calcpb.BatchOperation_Divide:
+		return "/calc.Calculator/Divide"
+	case *calcpb.BatchOperation_Modulo:
+		return "/calc.Calculator/Modulo"
+	case *calcpb.BatchOperation_IntegerPower:
+		return "/calc.Calculator/IntegerPower"
+	case *calcpb.BatchOperation_SquareRoot:
+		return "/calc.Calculator/SquareRoot"
+	case *calcpb.BatchOperation_Root:
+		return "/calc.Calculator/Root"
+	case *calcpb.BatchOperation_PrimeDecompose:
+		return "/calc.Calculator/PrimeDecompose"
+	case *calcpb.BatchOperation_Evaluate:
+		return "/calc.Calculator/Evaluate"
+	}
+	return ""
+}
+
+// calculate runs one operation of a batch.
+func (s *server) calculate(ctx context.Context, op *calcpb.BatchOperation) (*calcpb.BatchResult, error) {
+	// The operations call the handlers directly, so they are authorized,
+	// rate limited and validated here rather than by the interceptors.
+	if method := batchMethod(op); method != "" {
+		if s.policy != nil {
+			if err := s.policy.Authorize(ctx, method); err != nil {
+				return nil, err
+			}
+		}
+		if err := ratelimit.Allow(ctx, method); err != nil {
+			return nil, err
+		}
+	}
+	if err := validator.Check(op); err != nil {
+		return nil, err
+	}
+	switch op := op.GetOperation().(type) {
+	case *calcpb.BatchOperation_Sum:
+		res, err := s.CalculateSum(ctx, op.Sum)
+		return &calcpb.BatchResult{Result: &calcpb.BatchResult_Sum{Sum: res}}, err
+	case *calcpb.BatchOperation_Subtract:
+		res, err := s.Subtract(ctx, op.Subtract)
+		return &calcpb.BatchResult{Result: &calcpb.BatchResult_Subtract{Subtract: res}}, err
+	case *calcpb.BatchOperation_Multiply:
+		res, err := s.Multiply(ctx, op.Multiply)
+		return &calcpb.BatchResult{Result: &calcpb.BatchResult_Multiply{Multiply: res}}, err
+	case *calcpb.BatchOperation_Divide:
+		res, err := s.Divide(ctx, op.Divide)
+		return &calcpb.BatchResult{Result: &calcpb.BatchResult_Divide{Divide: res}}, err
+	case *calcpb.BatchOperation_Modulo:
+		res, err := s.Modulo(ctx, op.Modulo)
+		return &calcpb.BatchResult{Result: &calcpb.BatchResult_Modulo{Modulo: res}}, err
+	case *calcpb.BatchOperation_IntegerPower:
+		res, err := s.IntegerPower(ctx, op.IntegerPower)
+		return &calcpb.BatchResult{Result: &calcpb.BatchResult_IntegerPower{IntegerPower: res}}, err
+	case *calcpb.BatchOperation_SquareRoot:
+		res, err := s.SquareRoot(ctx, op.SquareRoot)
+		return &calcpb.BatchResult{Result: &calcpb.BatchResult_SquareRoot{SquareRoot: res}}, err
+	case *calcpb.BatchOperation_Root:
+		res, err := s.Root(ctx, op.Root)
+		return &calcpb.BatchResult{Result: &calcpb.BatchResult_Root{Root: res}}, err
+	case *calcpb.BatchOperation_PrimeDecompose:
+		factors := &calcpb.PrimeFactors{}
+		err := factorize(ctx, op.PrimeDecompose.GetNumber(), func(p int64) error {
+			factors.Numbers = append(factors.Numbers, p)
+			return nil
+		})
+		if err == context.Canceled || err == context.DeadlineExceeded {
+			err = status.FromContextError(err).Err()
+		}
+		return &calcpb.BatchResult{Result: &calcpb.BatchResult_PrimeDecompose{PrimeDecompose: factors}}, err
+	case *calcpb.BatchOperation_Evaluate:
+		res, err := s.Evaluate(ctx, op.Evaluate)
+		return &calcpb.BatchResult{Result: &calcpb.BatchResult_Evaluate{Evaluate: res}}, err
+	}
+	return nil, invalidArgument(reasonInvalidBatch, "operation", "The operation is not set")
+}
+
+func (s *server) BatchCalculate(ctx context.Context, req *calcpb.BatchCalculateRequest) (*calcpb.BatchCalculateResponse, error) {
+	ops := req.GetOperations()
+	if len(ops) > maxBatchSize {
+		return nil, invalidArgument(reasonInvalidBatch, "operations", fmt.Sprintf("Received %d operations, at most %d are allowed", len(ops), maxBatchSize))
+	}
+
+	// failed is cancelled on the first failure of a fail-fast batch.
+	failed, fail := context.WithCancel(ctx)
+	defer fail()
+	var failOnce sync.Once
+	firstFailure := -1
+
+	results := make([]*calcpb.BatchResult, len(ops))
+	next := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < batchWorkers && w < len(ops); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range next {
+				if failed.Err() != nil && ctx.Err() == nil {
+					results[i] = batchError(status.Error(codes.Aborted, "Skipped after a failed operation"))
+					continue
+				}
+				var res *calcpb.BatchResult
+				err := recovery.Call(ctx, "/calc.Calculator/BatchCalculate operation", func() error {
+					var err error
+					res, err = s.calculate(failed, ops[i])
+					return err
+				})
+				if err != nil {
+					res = batchError(err)
+					if req.GetFailFast() {
+						failOnce.Do(func() {
+							firstFailure = i
+							fail()
+						})
+					}
+				}
+				results[i] = res
+			}
+		}()
+	}
+	for i := range ops {
+		next <- i
+	}
+	close(next)
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return nil, status.FromContextError(err).Err()
+	}
+	// Operations cut short by the failure of another one are aborted too.
+	for i, res := range results {
+		if i != firstFailure && failed.Err() != nil && codes.Code(res.GetError().GetCode()) == codes.Canceled {
+			results[i] = batchError(status.Error(codes.Aborted, "Cancelled after a failed operation"))
+		}
+	}
+	return &calcpb.BatchCalculateResponse{
+		Results: results,
+	}, nil
+}
+
+func batchError(err error) *calcpb.BatchResult {
+	return &calcpb.BatchResult{
+		Result: &calcpb.BatchResult_Error{Error: status.Convert(err).Proto()},
+	}
+}
@@ -0,0 +1,116 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"grpc-course/calc/bigmath"
+	calcpb "grpc-course/calc/calc_proto"
+	"grpc-course/common/rpcerr"
+)
+
+var bigKinds = map[calcpb.BigOptions_Kind]bigmath.Kind{
+	calcpb.BigOptions_INTEGER:  bigmath.Integer,
+	calcpb.BigOptions_RATIONAL: bigmath.Rational,
+	calcpb.BigOptions_FLOAT:    bigmath.Float,
+}
+
+var bigRoundingModes = map[calcpb.BigOptions_RoundingMode]big.RoundingMode{
+	calcpb.BigOptions_TO_NEAREST_EVEN: big.ToNearestEven,
+	calcpb.BigOptions_TO_NEAREST_AWAY: big.ToNearestAway,
+	calcpb.BigOptions_TO_ZERO:         big.ToZero,
+	calcpb.BigOptions_AWAY_FROM_ZERO:  big.AwayFromZero,
+	calcpb.BigOptions_TO_NEGATIVE_INF: big.ToNegativeInf,
+	calcpb.BigOptions_TO_POSITIVE_INF: big.ToPositiveInf,
+}
+
+// computeBig applies op to the operands of req and maps the errors of
+// bigmath to gRPC statuses.
+func computeBig(ctx context.Context, op bigmath.Op, req *calcpb.BigRequest) (*calcpb.BigResponse, error) {
+	opts := req.GetOptions()
+	kind, ok := bigKinds[opts.GetKind()]
+	if !ok {
+		return nil, invalidArgument(reasonInvalidOption, "options.kind", fmt.Sprintf("Unknown kind %v", opts.GetKind()))
+	}
+	mode, ok := bigRoundingModes[opts.GetRounding()]
+	if !ok {
+		return nil, invalidArgument(reasonInvalidOption, "options.rounding", fmt.Sprintf("Unknown rounding mode %v", opts.GetRounding()))
+	}
+
+	x, err := bigOperand(ctx, "x", req.GetX(), req.GetXRegister())
+	if err != nil {
+		return nil, err
+	}
+	y, err := bigOperand(ctx, "y", req.GetY(), req.GetYRegister())
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := bigmath.Compute(op, x, y, bigmath.Options{
+		Kind: kind,
+		Prec: uint(opts.GetPrecision()),
+		Mode: mode,
+	})
+	var bigErr *bigmath.Error
+	if errors.As(err, &bigErr) {
+		return nil, bigError(op, bigErr)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Cannot compute %v: %v", op, err)
+	}
+	return &calcpb.BigResponse{
+		Result:    res.Value,
+		Remainder: res.Remainder,
+		Exact:     res.Exact,
+	}, nil
+}
+
+// bigError returns the status of err, INVALID_ARGUMENT or OUT_OF_RANGE when
+// the result is too large, with the violation of its field if it has one.
+func bigError(op bigmath.Op, err *bigmath.Error) error {
+	code, reason := codes.InvalidArgument, reasonInvalidOperand
+	field := err.Field
+	switch {
+	case err.TooLarge:
+		code, reason = codes.OutOfRange, reasonResultTooLarge
+	case field == "kind" || field == "precision":
+		reason = reasonInvalidOption
+	}
+	if field == "kind" || field == "precision" {
+		field = "options." + field
+	}
+	msg := fmt.Sprintf("Cannot compute %v: %v", op, err)
+	if field == "" {
+		return rpcerr.New(code, errorDomain, reason, msg, nil)
+	}
+	return rpcerr.New(code, errorDomain, reason, msg, nil, rpcerr.Violation(field, err.Msg))
+}
+
+func (*server) BigAdd(ctx context.Context, req *calcpb.BigRequest) (*calcpb.BigResponse, error) {
+	return computeBig(ctx, bigmath.Add, req)
+}
+
+func (*server) BigSubtract(ctx context.Context, req *calcpb.BigRequest) (*calcpb.BigResponse, error) {
+	return computeBig(ctx, bigmath.Sub, req)
+}
+
+func (*server) BigMultiply(ctx context.Context, req *calcpb.BigRequest) (*calcpb.BigResponse, error) {
+	return computeBig(ctx, bigmath.Mul, req)
+}
+
+func (*server) BigDivide(ctx context.Context, req *calcpb.BigRequest) (*calcpb.BigResponse, error) {
+	return computeBig(ctx, bigmath.Div, req)
+}
+
+func (*server) BigPower(ctx context.Context, req *calcpb.BigRequest) (*calcpb.BigResponse, error) {
+	return computeBig(ctx, bigmath.Pow, req)
+}
+
+func (*server) BigRoot(ctx context.Context, req *calcpb.BigRequest) (*calcpb.BigResponse, error) {
+	return computeBig(ctx, bigmath.Root, req)
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	calcpb "grpc-course/calc/calc_proto"
+	"grpc-course/common/cache"
+)
+
+// cachedMethods are the unary methods worth caching: their responses only
+// depend on their request and take a while to compute. Evaluate is missing
+// as it reads the session registers, and the requests of the others naming
+// registers are bypassed for the same reason.
+var cachedMethods = []string{
+	"/calc.Calculator/SquareRoot",
+	"/calc.Calculator/Root",
+	"/calc.Calculator/IsPrime",
+	"/calc.Calculator/Totient",
+	"/calc.Calculator/BigMultiply",
+	"/calc.Calculator/BigDivide",
+	"/calc.Calculator/BigPower",
+	"/calc.Calculator/BigRoot",
+}
+
+// cachedStreams are the server-streaming methods worth caching, replayed
+// from the cache.
+var cachedStreams = map[string]func() proto.Message{
+	"/calc.Calculator/PrimeDecompose": func() proto.Message { return new(calcpb.PrimeDecomposeRequest) },
+}
+
+// maxCachedBytes is the size of the largest request and response cached, so
+// an entry takes about 8 KiB at most and 10000 of them about 80 MB. The Big
+// operations take operands and return results far larger.
+const maxCachedBytes = 4 << 10
+
+// newResponseCache returns the interceptor caching the responses of the
+// Calculator, size responses kept for ttl.
+func newResponseCache(size int, ttl time.Duration) *cache.Interceptor {
+	return &cache.Interceptor{
+		Cache:    cache.New(size, ttl),
+		Unary:    cachedMethods,
+		Streams:  cachedStreams,
+		Bypass:   usesRegisters,
+		MaxBytes: maxCachedBytes,
+	}
+}
+
+// usesRegisters reports whether an operand of req names a session register.
+func usesRegisters(req interface{}) bool {
+	switch r := req.(type) {
+	case *calcpb.SquareRootRequest:
+		return r.GetNumberRegister() != ""
+	case *calcpb.RootRequest:
+		return r.GetNumberRegister() != ""
+	case *calcpb.BigRequest:
+		return r.GetXRegister() != "" || r.GetYRegister() != ""
+	}
+	return false
+}
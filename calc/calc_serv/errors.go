@@ -0,0 +1,43 @@
+package main
+
+import (
+	"grpc-course/common/rpcerr"
+	"grpc-course/common/validation"
+)
+
+// errorDomain is the domain of the ErrorInfo details of the Calculator.
+const errorDomain = "calc.grpc-course"
+
+// The reasons of the ErrorInfo details, documented at rpcerr.HelpURL.
+const (
+	reasonIntegerOverflow       = "INTEGER_OVERFLOW"
+	reasonDivisionByZero        = "DIVISION_BY_ZERO"
+	reasonNegativeExponent      = "NEGATIVE_EXPONENT"
+	reasonNegativeNumber        = "NEGATIVE_NUMBER"
+	reasonNonPositiveNumber     = "NON_POSITIVE_NUMBER"
+	reasonNonFiniteNumber       = "NON_FINITE_NUMBER"
+	reasonNoNumbers             = "NO_NUMBERS"
+	reasonInvalidModulus        = "INVALID_MODULUS"
+	reasonNotInvertible         = "NOT_INVERTIBLE"
+	reasonInvalidRange          = "INVALID_RANGE"
+	reasonInvalidOperand        = "INVALID_OPERAND"
+	reasonInvalidOption         = "INVALID_OPTION"
+	reasonInvalidExpression     = "INVALID_EXPRESSION"
+	reasonInvalidBatch          = "INVALID_BATCH"
+	reasonInvalidSession        = "INVALID_SESSION"
+	reasonResultTooLarge        = "RESULT_TOO_LARGE"
+	reasonWindowFull            = "WINDOW_FULL"
+	reasonTooManySessions       = "TOO_MANY_SESSIONS"
+	reasonTooManyCallerSessions = "TOO_MANY_CALLER_SESSIONS"
+	reasonTooManyRegisters      = "TOO_MANY_REGISTERS"
+	reasonRegisterNotSet        = "REGISTER_NOT_SET"
+)
+
+// validator checks the requests against the rules of calc.proto.
+var validator = &validation.Validator{Domain: errorDomain}
+
+// invalidArgument returns an INVALID_ARGUMENT error of the Calculator with
+// msg and the violation of field.
+func invalidArgument(reason, field, msg string) error {
+	return rpcerr.InvalidArgument(errorDomain, reason, msg, rpcerr.Violation(field, msg))
+}
@@ -0,0 +1,37 @@
+package main
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	calcpb "grpc-course/calc/calc_proto"
+	"grpc-course/calc/expr"
+	"grpc-course/common/rpcerr"
+)
+
+func (*server) Evaluate(ctx context.Context, req *calcpb.EvaluateRequest) (*calcpb.EvaluateResponse, error) {
+	// The registers of the session are variables too, shadowed by the ones
+	// of the request.
+	vars := req.GetVariables()
+	if sess, err := sessionFromContext(ctx); err == nil {
+		vars = sess.Registers()
+		for name, v := range req.GetVariables() {
+			vars[name] = v
+		}
+	}
+	result, err := expr.Evaluate(req.GetExpression(), vars)
+	var exprErr *expr.Error
+	switch {
+	case errors.As(err, &exprErr):
+		return nil, rpcerr.InvalidArgument(errorDomain, reasonInvalidExpression, "Invalid expression: "+exprErr.Error(),
+			rpcerr.Violation("expression", exprErr.Error()))
+	case err != nil:
+		return nil, status.Errorf(codes.Internal, "Cannot evaluate expression: %v", err)
+	}
+	return &calcpb.EvaluateResponse{
+		Result: result,
+	}, nil
+}
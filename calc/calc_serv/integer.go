@@ -0,0 +1,122 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	calcpb "grpc-course/calc/calc_proto"
+	"grpc-course/calc/checked"
+	"grpc-course/common/rpcerr"
+)
+
+// integerError returns the status of an operation of package checked that
+// failed with err. Overflows carry the operation and its operands in their
+// ErrorInfo.
+func integerError(op string, err error, operands map[string]int64) error {
+	switch err {
+	case checked.ErrOverflow:
+		md := map[string]string{"operation": op}
+		for name, v := range operands {
+			md[name] = strconv.FormatInt(v, 10)
+		}
+		return rpcerr.New(codes.OutOfRange, errorDomain, reasonIntegerOverflow, "The result of "+op+" overflows int64", md)
+	case checked.ErrDivisionByZero:
+		return invalidArgument(reasonDivisionByZero, "y", fmt.Sprintf("Cannot compute %s: %v", op, err))
+	case checked.ErrNegativeExponent:
+		return invalidArgument(reasonNegativeExponent, "exponent", fmt.Sprintf("Cannot compute %s: %v", op, err))
+	}
+	return status.Errorf(codes.Internal, "Cannot compute %s: %v", op, err)
+}
+
+func (*server) CalculateSum(ctx context.Context, req *calcpb.CalculateSumRequest) (*calcpb.CalculateSumResponse, error) {
+	x, y, err := intOperands(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	result, err := checked.Add(x, y)
+	if err != nil {
+		return nil, integerError("sum", err, map[string]int64{"x": x, "y": y})
+	}
+	return &calcpb.CalculateSumResponse{
+		Result: result,
+	}, nil
+}
+
+func (*server) Subtract(ctx context.Context, req *calcpb.SubtractRequest) (*calcpb.SubtractResponse, error) {
+	x, y, err := intOperands(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	result, err := checked.Sub(x, y)
+	if err != nil {
+		return nil, integerError("difference", err, map[string]int64{"x": x, "y": y})
+	}
+	return &calcpb.SubtractResponse{
+		Result: result,
+	}, nil
+}
+
+func (*server) Multiply(ctx context.Context, req *calcpb.MultiplyRequest) (*calcpb.MultiplyResponse, error) {
+	x, y, err := intOperands(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	result, err := checked.Mul(x, y)
+	if err != nil {
+		return nil, integerError("product", err, map[string]int64{"x": x, "y": y})
+	}
+	return &calcpb.MultiplyResponse{
+		Result: result,
+	}, nil
+}
+
+func (*server) Divide(ctx context.Context, req *calcpb.DivideRequest) (*calcpb.DivideResponse, error) {
+	x, y, err := intOperands(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	quo, rem, err := checked.Div(x, y)
+	if err != nil {
+		return nil, integerError("quotient", err, map[string]int64{"x": x, "y": y})
+	}
+	return &calcpb.DivideResponse{
+		Quotient:  quo,
+		Remainder: rem,
+	}, nil
+}
+
+func (*server) Modulo(ctx context.Context, req *calcpb.ModuloRequest) (*calcpb.ModuloResponse, error) {
+	x, y, err := intOperands(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	result, err := checked.Mod(x, y)
+	if err != nil {
+		return nil, integerError("modulo", err, map[string]int64{"x": x, "y": y})
+	}
+	return &calcpb.ModuloResponse{
+		Result: result,
+	}, nil
+}
+
+func (*server) IntegerPower(ctx context.Context, req *calcpb.IntegerPowerRequest) (*calcpb.IntegerPowerResponse, error) {
+	base, err := intOperand(ctx, "base", req.GetBase(), req.GetBaseRegister())
+	if err != nil {
+		return nil, err
+	}
+	exponent, err := intOperand(ctx, "exponent", req.GetExponent(), req.GetExponentRegister())
+	if err != nil {
+		return nil, err
+	}
+	result, err := checked.Pow(base, exponent)
+	if err != nil {
+		return nil, integerError("power", err, map[string]int64{"base": base, "exponent": exponent})
+	}
+	return &calcpb.IntegerPowerResponse{
+		Result: result,
+	}, nil
+}
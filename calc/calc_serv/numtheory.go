@@ -0,0 +1,152 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	calcpb "grpc-course/calc/calc_proto"
+	"grpc-course/calc/checked"
+	"grpc-course/calc/numtheory"
+	"grpc-course/common/rpcerr"
+)
+
+// abs returns |x|, which fits in a uint64 even for math.MinInt64.
+func abs(x int64) uint64 {
+	if x < 0 {
+		return uint64(-x)
+	}
+	return uint64(x)
+}
+
+// residue returns x mod m in [0, m), for m > 0.
+func residue(x, m int64) uint64 {
+	r := x % m
+	if r < 0 {
+		r += m
+	}
+	return uint64(r)
+}
+
+func (*server) IsPrime(ctx context.Context, req *calcpb.IsPrimeRequest) (*calcpb.IsPrimeResponse, error) {
+	number := req.GetNumber()
+	return &calcpb.IsPrimeResponse{
+		Prime: number > 0 && numtheory.IsPrime(uint64(number)),
+	}, nil
+}
+
+func (*server) GreatestCommonDivisor(ctx context.Context, req *calcpb.GreatestCommonDivisorRequest) (*calcpb.GreatestCommonDivisorResponse, error) {
+	numbers := req.GetNumbers()
+	if len(numbers) == 0 {
+		return nil, invalidArgument(reasonNoNumbers, "numbers", "Received no numbers")
+	}
+	var result uint64
+	for _, n := range numbers {
+		result = numtheory.GCD(result, abs(n))
+	}
+	// Only the gcd of math.MinInt64 and zeros is out of range.
+	if result > math.MaxInt64 {
+		return nil, integerError("greatest common divisor", checked.ErrOverflow, map[string]int64{"x": math.MinInt64})
+	}
+	return &calcpb.GreatestCommonDivisorResponse{
+		Result: int64(result),
+	}, nil
+}
+
+func (*server) LeastCommonMultiple(ctx context.Context, req *calcpb.LeastCommonMultipleRequest) (*calcpb.LeastCommonMultipleResponse, error) {
+	numbers := req.GetNumbers()
+	if len(numbers) == 0 {
+		return nil, invalidArgument(reasonNoNumbers, "numbers", "Received no numbers")
+	}
+	result := abs(numbers[0])
+	for _, n := range numbers[1:] {
+		lcm, ok := numtheory.LCM(result, abs(n))
+		if !ok || lcm > math.MaxInt64 {
+			return nil, integerError("least common multiple", checked.ErrOverflow, map[string]int64{"x": int64(result), "y": n})
+		}
+		result = lcm
+	}
+	if result > math.MaxInt64 {
+		return nil, integerError("least common multiple", checked.ErrOverflow, map[string]int64{"x": numbers[0]})
+	}
+	return &calcpb.LeastCommonMultipleResponse{
+		Result: int64(result),
+	}, nil
+}
+
+func (*server) ModularPower(ctx context.Context, req *calcpb.ModularPowerRequest) (*calcpb.ModularPowerResponse, error) {
+	base, exponent, modulus := req.GetBase(), req.GetExponent(), req.GetModulus()
+	if modulus <= 0 {
+		return nil, invalidArgument(reasonInvalidModulus, "modulus", fmt.Sprintf("Received non-positive modulus %v", modulus))
+	}
+	b := residue(base, modulus)
+	if exponent < 0 {
+		inv, ok := numtheory.ModInverse(b, uint64(modulus))
+		if !ok {
+			return nil, invalidArgument(reasonNotInvertible, "base", fmt.Sprintf("Cannot raise %v to a negative exponent: it has no inverse modulo %v", base, modulus))
+		}
+		b = inv
+	}
+	return &calcpb.ModularPowerResponse{
+		Result: int64(numtheory.ModPow(b, abs(exponent), uint64(modulus))),
+	}, nil
+}
+
+func (*server) ModularInverse(ctx context.Context, req *calcpb.ModularInverseRequest) (*calcpb.ModularInverseResponse, error) {
+	number, modulus := req.GetNumber(), req.GetModulus()
+	if modulus <= 0 {
+		return nil, invalidArgument(reasonInvalidModulus, "modulus", fmt.Sprintf("Received non-positive modulus %v", modulus))
+	}
+	inv, ok := numtheory.ModInverse(residue(number, modulus), uint64(modulus))
+	if !ok {
+		return nil, invalidArgument(reasonNotInvertible, "number", fmt.Sprintf("%v has no inverse modulo %v", number, modulus))
+	}
+	return &calcpb.ModularInverseResponse{
+		Result: int64(inv),
+	}, nil
+}
+
+func (*server) Totient(ctx context.Context, req *calcpb.TotientRequest) (*calcpb.TotientResponse, error) {
+	number := req.GetNumber()
+	if number <= 0 {
+		return nil, invalidArgument(reasonNonPositiveNumber, "number", fmt.Sprintf("Received non-positive number %v", number))
+	}
+	phi, err := numtheory.Totient(ctx, uint64(number), factorBudget)
+	if err == numtheory.ErrBudgetExhausted {
+		return nil, status.Errorf(codes.ResourceExhausted, "Factoring %v: %v", number, err)
+	}
+	if err != nil {
+		return nil, status.FromContextError(err).Err()
+	}
+	return &calcpb.TotientResponse{
+		Result: int64(phi),
+	}, nil
+}
+
+func (*server) PrimesInRange(req *calcpb.PrimesInRangeRequest, stream calcpb.Calculator_PrimesInRangeServer) error {
+	from, to := req.GetFrom(), req.GetTo()
+	if from > to {
+		return invalidArgument(reasonInvalidRange, "to", fmt.Sprintf("Received empty range [%v, %v]", from, to))
+	}
+	if uint64(to-from) >= numtheory.MaxRange {
+		return invalidArgument(reasonInvalidRange, "to", fmt.Sprintf("Range [%v, %v] holds more than %v numbers", from, to, numtheory.MaxRange))
+	}
+	if to < 2 {
+		return nil
+	}
+	if from < 2 {
+		from = 2
+	}
+	err := numtheory.PrimesInRange(stream.Context(), uint64(from), uint64(to), func(p uint64) error {
+		return stream.Send(&calcpb.PrimesInRangeResponse{
+			Number: int64(p),
+		})
+	})
+	if err != nil {
+		return rpcerr.FromStream(stream.Context(), "sending prime", err)
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+
+	calcpb "grpc-course/calc/calc_proto"
+)
+
+// nthRoot returns the real n-th root of x >= 0, refining math.Pow with a
+// step of Newton's method.
+func nthRoot(x float64, n uint32) float64 {
+	switch {
+	case n == 1 || x == 0 || math.IsInf(x, 1):
+		return x
+	case n == 2:
+		return math.Sqrt(x)
+	case n == 3:
+		return math.Cbrt(x)
+	}
+	r := math.Pow(x, 1/float64(n))
+	if p := math.Pow(r, float64(n-1)); p != 0 && !math.IsInf(p, 0) {
+		r -= (p*r - x) / (float64(n) * p)
+	}
+	return r
+}
+
+// roundSignificant rounds x to digits significant digits.
+func roundSignificant(x float64, digits uint32) float64 {
+	if digits == 0 || x == 0 {
+		return x
+	}
+	r, _ := strconv.ParseFloat(strconv.FormatFloat(x, 'g', int(digits), 64), 64)
+	return r
+}
+
+func (*server) Root(ctx context.Context, req *calcpb.RootRequest) (*calcpb.RootResponse, error) {
+	number, err := floatOperand(ctx, "number", req.GetNumber(), req.GetNumberRegister())
+	if err != nil {
+		return nil, err
+	}
+	if math.IsNaN(number) || math.IsInf(number, 0) {
+		return nil, invalidArgument(reasonNonFiniteNumber, "number", fmt.Sprintf("Received non-finite number %v", number))
+	}
+	degree := req.GetDegree()
+	if degree == 0 {
+		degree = 2
+	}
+	// significant_digits is bounded by its rule in calc.proto.
+	digits := req.GetSignificantDigits()
+
+	var re, im float64
+	switch {
+	case number >= 0:
+		re = nthRoot(number, degree)
+	case degree%2 == 1:
+		re = -nthRoot(-number, degree)
+	case req.GetComplex():
+		// The principal root has the argument pi/degree.
+		r := nthRoot(-number, degree)
+		sin, cos := math.Sincos(math.Pi / float64(degree))
+		re, im = r*cos, r*sin
+		if degree == 2 {
+			re = 0
+		}
+	default:
+		return nil, invalidArgument(reasonNegativeNumber, "number", fmt.Sprintf(
+			"Received negative number %v for a root of even degree %d",
+			number,
+			degree,
+		))
+	}
+	return &calcpb.RootResponse{
+		Real:      roundSignificant(re, digits),
+		Imaginary: roundSignificant(im, digits),
+	}, nil
+}
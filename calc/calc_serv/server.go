@@ -7,14 +7,30 @@ import (
 	"math"
 	"net"
 	"os"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 	"google.golang.org/grpc/status"
 
 	calcpb "grpc-course/calc/calc_proto"
+	"grpc-course/calc/numtheory"
+	"grpc-course/calc/session"
+	"grpc-course/common/auth"
+	"grpc-course/common/config"
+	"grpc-course/common/gateway"
+	"grpc-course/common/logging"
+	"grpc-course/common/metrics"
+	"grpc-course/common/ratelimit"
+	"grpc-course/common/readiness"
+	"grpc-course/common/recovery"
+	"grpc-course/common/rpcerr"
+	"grpc-course/common/shutdown"
+	"grpc-course/common/tracing"
 )
 
 func init() {
@@ -22,55 +38,46 @@ func init() {
 	log.SetLevel(log.InfoLevel)
 }
 
-type server struct{}
-
-func (*server) CalculateSum(ctx context.Context, req *calcpb.CalculateSumRequest) (*calcpb.CalculateSumResponse, error) {
-	log.Infof("Received unary call to calculate sum with request: %v", req)
-	return &calcpb.CalculateSumResponse{
-		Result: req.X + req.Y,
-	}, nil
+type server struct {
+	sessions *session.Store
+	// clients tells apart the callers the sessions are counted against.
+	clients *ratelimit.Clients
+	// policy holds the scopes the operations of a batch need, nil when auth
+	// is disabled.
+	policy *auth.Policy
 }
 
-func (*server) PrimeDecompose(req *calcpb.PrimeDecomposeRequest, stream calcpb.Calculator_PrimeDecomposeServer) error {
-	log.Infof("Received server stream call to decompose to prime numbers with request: %v", req)
-
-	num := req.Number
-	var d int64 = 2
-	for num > 1 {
-		if num%d == 0 {
-			stream.Send(&calcpb.PrimeDecomposeResponse{
-				Number: d,
-			})
-			num = num / d
-		} else {
-			d = d + 1
-		}
-	}
+// factorBudget caps the trial divisions and rho iterations spent on factoring
+// one number. Any int64 needs far fewer.
+const factorBudget = 1 << 24
 
-	return nil
+// factorize calls emit with the prime factors of num in increasing order.
+func factorize(ctx context.Context, num int64, emit func(p int64) error) error {
+	if num <= 0 {
+		return invalidArgument(reasonNonPositiveNumber, "number", fmt.Sprintf("Received non-positive number %v", num))
+	}
+	err := numtheory.Factor(ctx, uint64(num), factorBudget, func(p uint64) error {
+		return emit(int64(p))
+	})
+	if err == numtheory.ErrBudgetExhausted {
+		return status.Errorf(codes.ResourceExhausted, "Factoring %v: %v", num, err)
+	}
+	return err
 }
 
-func (*server) CalculateAverage(stream calcpb.Calculator_CalculateAverageServer) error {
-	sum := 0.0
-	count := 0.0
-	for {
-		req, err := stream.Recv()
-		if err == io.EOF {
-			return stream.SendAndClose(&calcpb.CalculateAverageResponse{
-				Average: (sum / count),
-			})
-		}
-		if err != nil {
-			log.Fatalf("error reading from stream: %v", err)
-		}
-
-		sum += req.Number
-		count++
+func (*server) PrimeDecompose(req *calcpb.PrimeDecomposeRequest, stream calcpb.Calculator_PrimeDecomposeServer) error {
+	err := factorize(stream.Context(), req.GetNumber(), func(p int64) error {
+		return stream.Send(&calcpb.PrimeDecomposeResponse{
+			Number: p,
+		})
+	})
+	if err != nil {
+		return rpcerr.FromStream(stream.Context(), "sending factor", err)
 	}
+	return nil
 }
 
 func (*server) FindMax(stream calcpb.Calculator_FindMaxServer) error {
-	log.Info("Processing request for bi dir streaming")
 	max := int64(math.MinInt64)
 	for {
 		req, err := stream.Recv()
@@ -78,8 +85,7 @@ func (*server) FindMax(stream calcpb.Calculator_FindMaxServer) error {
 			return nil
 		}
 		if err != nil {
-			log.Fatalf("Received error while reading request: %v", err)
-			return err
+			return rpcerr.FromStream(stream.Context(), "reading number", err)
 		}
 		if n := req.Number; n > max {
 			max = n
@@ -87,20 +93,19 @@ func (*server) FindMax(stream calcpb.Calculator_FindMaxServer) error {
 				Number: max,
 			})
 			if err != nil {
-				log.Fatalf("Error while sending to stream: %v", err)
-				return err
+				return rpcerr.FromStream(stream.Context(), "sending maximum", err)
 			}
 		}
 	}
 }
 
 func (*server) SquareRoot(ctx context.Context, req *calcpb.SquareRootRequest) (*calcpb.SquareRootResponse, error) {
-	number := req.GetNumber()
+	number, err := intOperand(ctx, "number", req.GetNumber(), req.GetNumberRegister())
+	if err != nil {
+		return nil, err
+	}
 	if number < 0 {
-		return nil, status.Errorf(
-			codes.InvalidArgument,
-			fmt.Sprintf("Received negative number %v", number),
-		)
+		return nil, invalidArgument(reasonNegativeNumber, "number", fmt.Sprintf("Received negative number %v", number))
 	}
 	return &calcpb.SquareRootResponse{
 		Result: math.Sqrt(float64(number)),
@@ -108,21 +113,100 @@ func (*server) SquareRoot(ctx context.Context, req *calcpb.SquareRootRequest) (*
 }
 
 func main() {
+	defaults := config.Defaults()
+	defaults.Cache = config.Cache{Size: 10000, TTL: 10 * time.Minute}
+	cfg, err := config.Load("calc", defaults, os.Args[1:])
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	log.SetLevel(cfg.Level())
+	logging.SetFormat(cfg.LogFormat)
 	log.Info("Setting up server...")
 
-	lis, err := net.Listen("tcp", "localhost:50051")
+	shutdownTracing, err := tracing.Setup(context.Background(), cfg.Tracing, "calc_serv")
+	if err != nil {
+		log.Fatalf("error setting up tracing: %v", err)
+	}
+	defer shutdownTracing(context.Background())
+
+	opts, err := cfg.ServerOptions("calc.Calculator")
+	if err != nil {
+		log.Fatalf("error configuring server: %v", err)
+	}
+
+	lis, err := net.Listen("tcp", cfg.ListenAddr)
 	if err != nil {
 		log.Fatalf("error listening: %v", err)
 	}
 
-	s := grpc.NewServer()
+	healthSrv := health.NewServer()
+	reporter := &readiness.Reporter{
+		Health:   healthSrv,
+		Services: []string{"calc.Calculator"},
+		Checks:   cfg.ReadinessChecks(),
+		Interval: 5 * time.Second,
+	}
+	reporter.NotServing()
+	drainer := &shutdown.Drainer{Timeout: cfg.DrainTimeout, Health: healthSrv}
+	var serverOpts []grpc.ServerOption
+	serverOpts = append(serverOpts, tracing.ServerOptions()...)
+	serverOpts = append(serverOpts, metrics.ServerOptions()...)
+	serverOpts = append(serverOpts, logging.New(cfg.LogOptions()).ServerOptions()...)
+	serverOpts = append(serverOpts, recovery.ServerOptions()...)
+	serverOpts = append(serverOpts, drainer.ServerOptions()...)
+	serverOpts = append(serverOpts, opts...)
+	serverOpts = append(serverOpts, validator.ServerOptions()...)
+	// Sessions come after auth so only authorized requests create them.
+	srv := &server{
+		sessions: session.NewStore(30*time.Minute, 10000, maxSessionsPerCaller, 100),
+		clients:  cfg.Clients(),
+		policy:   cfg.AuthPolicy(),
+	}
+	serverOpts = append(serverOpts, grpc.ChainUnaryInterceptor(srv.sessionInterceptor))
+	// The cache comes after sessions so the cached calls still make the
+	// history.
+	if cfg.Cache.Size > 0 {
+		serverOpts = append(serverOpts, newResponseCache(cfg.Cache.Size, cfg.Cache.TTL).ServerOptions()...)
+	}
+	s := grpc.NewServer(serverOpts...)
+	healthpb.RegisterHealthServer(s, healthSrv)
 
-	calcpb.RegisterCalculatorServer(s, &server{})
+	calcpb.RegisterCalculatorServer(s, srv)
 
 	// Register reflection service
 	reflection.Register(s)
 
-	if err := s.Serve(lis); err != nil {
+	metrics.Initialize(s)
+
+	ctx := shutdown.SignalContext()
+
+	if cfg.GatewayAddr != "" {
+		dialOpts, err := cfg.GatewayDialOptions()
+		if err != nil {
+			log.Fatalf("error configuring gateway: %v", err)
+		}
+		go func() {
+			err := gateway.Serve(ctx, cfg.GatewayAddr, cfg.LocalEndpoint(), dialOpts, calcpb.RegisterCalculatorHandlerFromEndpoint)
+			if err != nil {
+				log.Fatalf("error serving gateway: %v", err)
+			}
+		}()
+	}
+
+	if cfg.MetricsAddr != "" {
+		go func() {
+			if err := metrics.Serve(ctx, cfg.MetricsAddr); err != nil {
+				log.Fatalf("error serving metrics: %v", err)
+			}
+		}()
+	}
+
+	go reporter.Run(ctx)
+	go srv.sessions.Run(ctx, time.Minute)
+
+	if err := drainer.Serve(ctx, s, lis); err != nil {
 		log.Fatalf("error serving: %v", err)
 	}
+	log.Info("Server stopped")
 }
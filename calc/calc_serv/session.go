@@ -0,0 +1,286 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+	"unicode/utf8"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	calcpb "grpc-course/calc/calc_proto"
+	"grpc-course/calc/session"
+	"grpc-course/common/auth"
+	"grpc-course/common/rpcerr"
+)
+
+// sessionHeader is the metadata key carrying the session ID.
+const sessionHeader = "x-session-id"
+
+// maxSessionsPerCaller caps the sessions a single caller can create, so it
+// cannot take all of them from the others.
+const maxSessionsPerCaller = 100
+
+// maxHistoryMessage is the size in bytes of the largest request, response or
+// error kept in the history. Larger messages are recorded by size only and
+// larger errors by code and truncated message.
+const maxHistoryMessage = 1024
+
+type sessionKey struct{}
+
+// sessionError returns the status of an error of package session.
+func sessionError(err error) error {
+	switch err {
+	case session.ErrInvalidID:
+		return invalidArgument(reasonInvalidSession, sessionHeader, err.Error())
+	case session.ErrInvalidName:
+		return invalidArgument(reasonInvalidSession, "name", err.Error())
+	case session.ErrTooManySessions:
+		return rpcerr.New(codes.ResourceExhausted, errorDomain, reasonTooManySessions,
+			"Too many sessions, retry once idle ones expire", nil)
+	case session.ErrTooManyOwnerSessions:
+		return rpcerr.New(codes.ResourceExhausted, errorDomain, reasonTooManyCallerSessions,
+			fmt.Sprintf("The caller already has the maximum of %d sessions", maxSessionsPerCaller),
+			map[string]string{"max_sessions": fmt.Sprint(maxSessionsPerCaller)})
+	case session.ErrTooManyRegisters:
+		return rpcerr.New(codes.ResourceExhausted, errorDomain, reasonTooManyRegisters,
+			fmt.Sprintf("The session already holds the maximum of %d registers", session.MaxRegisters),
+			map[string]string{"max_registers": fmt.Sprint(session.MaxRegisters)})
+	}
+	return status.Error(codes.Internal, err.Error())
+}
+
+// sessionInterceptor attaches the session of the request, if any, to the
+// context and records the operation in its history. With authentication on,
+// the session IDs are scoped to the subject of the token so callers cannot
+// reach the sessions of others. The sessions are counted against the caller
+// that created them, told apart like the rate limiter does.
+func (s *server) sessionInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	ids := md.Get(sessionHeader)
+	if len(ids) == 0 {
+		return handler(ctx, req)
+	}
+	key := ids[0]
+	if claims, ok := auth.ClaimsFromContext(ctx); ok && ids[0] != "" {
+		key = claims.Subject + "/" + ids[0]
+	}
+	sess, err := s.sessions.Get(key, s.clients.Client(ctx), time.Now())
+	if err == session.ErrInvalidID {
+		return nil, invalidArgument(reasonInvalidSession, sessionHeader, fmt.Sprintf("Invalid %s: %v", sessionHeader, err))
+	}
+	if err != nil {
+		return nil, sessionError(err)
+	}
+
+	resp, err := handler(context.WithValue(ctx, sessionKey{}, sess), req)
+	if info.FullMethod != "/calc.Calculator/ListHistory" {
+		e := session.Entry{Time: time.Now(), Method: info.FullMethod}
+		e.Request, e.RequestSize = historyMessage(req)
+		if err == nil {
+			e.Response, e.ResponseSize = historyMessage(resp)
+		} else {
+			e.Err = historyError(err)
+		}
+		sess.Record(e)
+	}
+	return resp, err
+}
+
+// historyMessage returns m packed for the history, nil when it is larger than
+// maxHistoryMessage, and its size.
+func historyMessage(m interface{}) (*anypb.Any, int) {
+	msg, ok := m.(proto.Message)
+	if !ok {
+		return nil, 0
+	}
+	size := proto.Size(msg)
+	if size > maxHistoryMessage {
+		return nil, size
+	}
+	a, err := ptypes.MarshalAny(msg)
+	if err != nil {
+		return nil, size
+	}
+	return a, size
+}
+
+// historyError returns err for the history, keeping only its code and the
+// start of its message when its status is larger than maxHistoryMessage.
+func historyError(err error) error {
+	st := status.Convert(err)
+	if proto.Size(st.Proto()) <= maxHistoryMessage {
+		return st.Err()
+	}
+	msg := st.Message()
+	if len(msg) > maxHistoryMessage/2 {
+		n := maxHistoryMessage / 2
+		for n > 0 && !utf8.RuneStart(msg[n]) {
+			n--
+		}
+		msg = msg[:n] + "..."
+	}
+	return status.Error(st.Code(), msg)
+}
+
+// sessionFromContext returns the session of the request, failing when the
+// request has none.
+func sessionFromContext(ctx context.Context) (*session.Session, error) {
+	sess, ok := ctx.Value(sessionKey{}).(*session.Session)
+	if !ok {
+		return nil, invalidArgument(reasonInvalidSession, sessionHeader, fmt.Sprintf("Missing %s metadata", sessionHeader))
+	}
+	return sess, nil
+}
+
+// register returns the value of the register name of the session of the
+// request.
+func register(ctx context.Context, name string) (float64, error) {
+	sess, err := sessionFromContext(ctx)
+	if err != nil {
+		return 0, err
+	}
+	v, ok := sess.Recall(name)
+	if !ok {
+		return 0, rpcerr.New(codes.NotFound, errorDomain, reasonRegisterNotSet,
+			fmt.Sprintf("Register %q is not set", name), map[string]string{"name": name})
+	}
+	return v, nil
+}
+
+// operandConflict returns the error of an operand field of a request set both
+// to a value and to a register.
+func operandConflict(field string) error {
+	return invalidArgument(reasonInvalidOperand, field+"_register", fmt.Sprintf("Only one of %s and %s_register can be set", field, field))
+}
+
+// intOperand returns the operand field of a request, v, or the value of the
+// register reg when it is set, which must be an int64.
+func intOperand(ctx context.Context, field string, v int64, reg string) (int64, error) {
+	if reg == "" {
+		return v, nil
+	}
+	if v != 0 {
+		return 0, operandConflict(field)
+	}
+	f, err := register(ctx, reg)
+	if err != nil {
+		return 0, err
+	}
+	// -2^63 and 2^63 are exact float64s, unlike math.MaxInt64.
+	if f != math.Trunc(f) || f < math.MinInt64 || f >= math.MaxInt64 {
+		return 0, invalidArgument(reasonInvalidOperand, field+"_register", fmt.Sprintf("Register %q holds %v, which is not an int64", reg, f))
+	}
+	return int64(f), nil
+}
+
+// xyOperands is a request of two int64 operands, x and y.
+type xyOperands interface {
+	GetX() int64
+	GetXRegister() string
+	GetY() int64
+	GetYRegister() string
+}
+
+// intOperands returns the operands x and y of req.
+func intOperands(ctx context.Context, req xyOperands) (int64, int64, error) {
+	x, err := intOperand(ctx, "x", req.GetX(), req.GetXRegister())
+	if err != nil {
+		return 0, 0, err
+	}
+	y, err := intOperand(ctx, "y", req.GetY(), req.GetYRegister())
+	if err != nil {
+		return 0, 0, err
+	}
+	return x, y, nil
+}
+
+// floatOperand returns the operand field of a request, v, or the value of the
+// register reg when it is set.
+func floatOperand(ctx context.Context, field string, v float64, reg string) (float64, error) {
+	if reg == "" {
+		return v, nil
+	}
+	if v != 0 {
+		return 0, operandConflict(field)
+	}
+	return register(ctx, reg)
+}
+
+// bigOperand returns the operand field of a request, v, or the value of the
+// register reg when it is set, as the shortest decimal that parses back to
+// it.
+func bigOperand(ctx context.Context, field, v, reg string) (string, error) {
+	if reg == "" {
+		return v, nil
+	}
+	if v != "" {
+		return "", operandConflict(field)
+	}
+	f, err := register(ctx, reg)
+	if err != nil {
+		return "", err
+	}
+	return strconv.FormatFloat(f, 'f', -1, 64), nil
+}
+
+func (*server) StoreMemory(ctx context.Context, req *calcpb.StoreMemoryRequest) (*calcpb.StoreMemoryResponse, error) {
+	sess, err := sessionFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := sess.Store(req.GetName(), req.GetValue()); err != nil {
+		return nil, sessionError(err)
+	}
+	return &calcpb.StoreMemoryResponse{}, nil
+}
+
+func (*server) RecallMemory(ctx context.Context, req *calcpb.RecallMemoryRequest) (*calcpb.RecallMemoryResponse, error) {
+	v, err := register(ctx, req.GetName())
+	if err != nil {
+		return nil, err
+	}
+	return &calcpb.RecallMemoryResponse{
+		Value: v,
+	}, nil
+}
+
+func (*server) ClearMemory(ctx context.Context, req *calcpb.ClearMemoryRequest) (*calcpb.ClearMemoryResponse, error) {
+	sess, err := sessionFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sess.Clear(req.GetName())
+	return &calcpb.ClearMemoryResponse{}, nil
+}
+
+func (*server) ListHistory(ctx context.Context, req *calcpb.ListHistoryRequest) (*calcpb.ListHistoryResponse, error) {
+	sess, err := sessionFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res := &calcpb.ListHistoryResponse{}
+	for _, e := range sess.History(int(req.GetLimit())) {
+		entry := &calcpb.HistoryEntry{
+			Method:       e.Method,
+			RequestSize:  uint32(e.RequestSize),
+			ResponseSize: uint32(e.ResponseSize),
+		}
+		entry.Time, _ = ptypes.TimestampProto(e.Time)
+		entry.Request, _ = e.Request.(*anypb.Any)
+		entry.Response, _ = e.Response.(*anypb.Any)
+		if e.Err != nil {
+			entry.Error = status.Convert(e.Err).Proto()
+		}
+		res.Entries = append(res.Entries, entry)
+	}
+	return res, nil
+}
@@ -0,0 +1,114 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"math"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	calcpb "grpc-course/calc/calc_proto"
+	"grpc-course/calc/stats"
+	"grpc-course/common/rpcerr"
+)
+
+// numberStream is the client stream of numbers of CalculateAverage and
+// CalculateStatistics.
+type numberStream interface {
+	Recv() (float64, error)
+}
+
+type averageStream struct {
+	calcpb.Calculator_CalculateAverageServer
+}
+
+func (s averageStream) Recv() (float64, error) {
+	req, err := s.Calculator_CalculateAverageServer.Recv()
+	return req.GetNumber(), err
+}
+
+type statisticsStream struct {
+	calcpb.Calculator_CalculateStatisticsServer
+}
+
+func (s statisticsStream) Recv() (float64, error) {
+	req, err := s.Calculator_CalculateStatisticsServer.Recv()
+	return req.GetNumber(), err
+}
+
+// readNumbers passes the numbers of the stream to add until the client
+// closes it, rejecting empty streams and numbers that are not finite.
+func readNumbers(stream numberStream, add func(float64) error) error {
+	count := 0
+	for {
+		n, err := stream.Recv()
+		if err == io.EOF {
+			if count == 0 {
+				return invalidArgument(reasonNoNumbers, "number", "Received no numbers")
+			}
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		count++
+		if err := add(n); err == stats.ErrNotFinite {
+			return invalidArgument(reasonNonFiniteNumber, "number", fmt.Sprintf("Received non-finite number %v at position %d", n, count))
+		}
+	}
+}
+
+func finite(values ...float64) bool {
+	for _, v := range values {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return false
+		}
+	}
+	return true
+}
+
+func (*server) CalculateAverage(stream calcpb.Calculator_CalculateAverageServer) error {
+	var summary stats.Summary
+	if err := readNumbers(averageStream{stream}, summary.Add); err != nil {
+		return rpcerr.FromStream(stream.Context(), "reading number", err)
+	}
+	if !finite(summary.Mean()) {
+		return status.Error(codes.OutOfRange, "The average of the numbers overflows float64")
+	}
+	return stream.SendAndClose(&calcpb.CalculateAverageResponse{
+		Average: summary.Mean(),
+	})
+}
+
+func (*server) CalculateStatistics(stream calcpb.Calculator_CalculateStatisticsServer) error {
+	var summary stats.Summary
+	quantiles := stats.NewQuantiles(0.5, 0.9, 0.99)
+	err := readNumbers(statisticsStream{stream}, func(n float64) error {
+		if err := summary.Add(n); err != nil {
+			return err
+		}
+		return quantiles.Add(n)
+	})
+	if err != nil {
+		return rpcerr.FromStream(stream.Context(), "reading number", err)
+	}
+
+	q := quantiles.Values()
+	res := &calcpb.CalculateStatisticsResponse{
+		Count:             summary.Count(),
+		Mean:              summary.Mean(),
+		Variance:          summary.Variance(),
+		StandardDeviation: summary.StdDev(),
+		Min:               summary.Min(),
+		Max:               summary.Max(),
+		Median:            q[0],
+		P90:               q[1],
+		P99:               q[2],
+		Approximate:       !quantiles.Exact(),
+	}
+	if !finite(res.Mean, res.Variance, res.StandardDeviation, res.Median, res.P90, res.P99) {
+		return status.Error(codes.OutOfRange, "The statistics of the numbers overflow float64")
+	}
+	return stream.SendAndClose(res)
+}
@@ -0,0 +1,101 @@
+// Package checked implements int64 arithmetic that reports overflows and
+// divisions by zero instead of wrapping or panicking.
+package checked
+
+import (
+	"errors"
+	"math"
+)
+
+var (
+	// ErrOverflow is returned when the result does not fit in an int64.
+	ErrOverflow = errors.New("integer overflow")
+	// ErrDivisionByZero is returned when dividing by zero.
+	ErrDivisionByZero = errors.New("division by zero")
+	// ErrNegativeExponent is returned by Pow for negative exponents.
+	ErrNegativeExponent = errors.New("negative exponent")
+)
+
+// Add returns x + y.
+func Add(x, y int64) (int64, error) {
+	z := x + y
+	if (z > x) != (y > 0) {
+		return 0, ErrOverflow
+	}
+	return z, nil
+}
+
+// Sub returns x - y.
+func Sub(x, y int64) (int64, error) {
+	z := x - y
+	if (z < x) != (y > 0) {
+		return 0, ErrOverflow
+	}
+	return z, nil
+}
+
+// Mul returns x * y.
+func Mul(x, y int64) (int64, error) {
+	if x == 0 || y == 0 {
+		return 0, nil
+	}
+	z := x * y
+	if (x == -1 && y == math.MinInt64) || (y == -1 && x == math.MinInt64) || z/y != x {
+		return 0, ErrOverflow
+	}
+	return z, nil
+}
+
+// Div returns the quotient of x by y truncated towards zero, and the
+// remainder, which has the sign of x.
+func Div(x, y int64) (quo, rem int64, err error) {
+	if y == 0 {
+		return 0, 0, ErrDivisionByZero
+	}
+	if x == math.MinInt64 && y == -1 {
+		return 0, 0, ErrOverflow
+	}
+	return x / y, x % y, nil
+}
+
+// Mod returns x modulo y, which is always in [0, |y|).
+func Mod(x, y int64) (int64, error) {
+	if y == 0 {
+		return 0, ErrDivisionByZero
+	}
+	if y == -1 {
+		return 0, nil
+	}
+	m := x % y
+	if m < 0 {
+		if y < 0 {
+			m -= y
+		} else {
+			m += y
+		}
+	}
+	return m, nil
+}
+
+// Pow returns base raised to the power exp >= 0.
+func Pow(base, exp int64) (int64, error) {
+	if exp < 0 {
+		return 0, ErrNegativeExponent
+	}
+	z := int64(1)
+	for {
+		var err error
+		if exp&1 == 1 {
+			if z, err = Mul(z, base); err != nil {
+				return 0, err
+			}
+		}
+		exp >>= 1
+		if exp == 0 {
+			return z, nil
+		}
+		if base, err = Mul(base, base); err != nil {
+			return 0, err
+		}
+	}
+}
@@ -0,0 +1,132 @@
+package checked
+
+import (
+	"math"
+	"math/big"
+	"testing"
+)
+
+// edges are the operands around the limits of int64.
+var edges = []int64{
+	math.MinInt64, math.MinInt64 + 1, math.MinInt32 - 1, math.MinInt32,
+	-3037000500, -3037000499, -2, -1, 0, 1, 2, 3037000499, 3037000500,
+	math.MaxInt32, math.MaxInt32 + 1, math.MaxInt64 - 1, math.MaxInt64,
+}
+
+// exact returns the result of op on x and y computed with math/big, and
+// whether it fits in an int64.
+func exact(op func(z, x, y *big.Int) *big.Int, x, y int64) (int64, bool) {
+	z := op(new(big.Int), big.NewInt(x), big.NewInt(y))
+	return z.Int64(), z.IsInt64()
+}
+
+func TestAddSubMul(t *testing.T) {
+	ops := []struct {
+		name    string
+		checked func(x, y int64) (int64, error)
+		exact   func(z, x, y *big.Int) *big.Int
+	}{
+		{"Add", Add, (*big.Int).Add},
+		{"Sub", Sub, (*big.Int).Sub},
+		{"Mul", Mul, (*big.Int).Mul},
+	}
+	for _, op := range ops {
+		for _, x := range edges {
+			for _, y := range edges {
+				got, err := op.checked(x, y)
+				want, ok := exact(op.exact, x, y)
+				switch {
+				case !ok && err != ErrOverflow:
+					t.Errorf("%s(%d, %d) = %d, %v, want ErrOverflow", op.name, x, y, got, err)
+				case ok && (err != nil || got != want):
+					t.Errorf("%s(%d, %d) = %d, %v, want %d", op.name, x, y, got, err, want)
+				}
+			}
+		}
+	}
+}
+
+func TestDiv(t *testing.T) {
+	tests := []struct {
+		x, y, quo, rem int64
+		err            error
+	}{
+		{7, 2, 3, 1, nil},
+		{-7, 2, -3, -1, nil},
+		{7, -2, -3, 1, nil},
+		{-7, -2, 3, -1, nil},
+		{0, 5, 0, 0, nil},
+		{5, 0, 0, 0, ErrDivisionByZero},
+		{math.MinInt64, -1, 0, 0, ErrOverflow},
+		{math.MinInt64, 1, math.MinInt64, 0, nil},
+		{math.MinInt64, 2, math.MinInt64 / 2, 0, nil},
+		{math.MinInt64, math.MaxInt64, -1, -1, nil},
+		{math.MaxInt64, -1, -math.MaxInt64, 0, nil},
+		{math.MaxInt64, math.MinInt64, 0, math.MaxInt64, nil},
+	}
+	for _, tt := range tests {
+		quo, rem, err := Div(tt.x, tt.y)
+		if err != tt.err || err == nil && (quo != tt.quo || rem != tt.rem) {
+			t.Errorf("Div(%d, %d) = %d, %d, %v, want %d, %d, %v", tt.x, tt.y, quo, rem, err, tt.quo, tt.rem, tt.err)
+		}
+	}
+}
+
+func TestMod(t *testing.T) {
+	tests := []struct {
+		x, y, want int64
+		err        error
+	}{
+		{7, 3, 1, nil},
+		{-7, 3, 2, nil},
+		{7, -3, 1, nil},
+		{-7, -3, 2, nil},
+		{-6, 3, 0, nil},
+		{5, 0, 0, ErrDivisionByZero},
+		{math.MinInt64, -1, 0, nil},
+		{math.MinInt64, math.MaxInt64, math.MaxInt64 - 1, nil},
+		{math.MinInt64 + 1, math.MinInt64, 1, nil},
+		{-1, math.MinInt64, math.MaxInt64, nil},
+		{-1, math.MaxInt64, math.MaxInt64 - 1, nil},
+	}
+	for _, tt := range tests {
+		got, err := Mod(tt.x, tt.y)
+		if err != tt.err || got != tt.want {
+			t.Errorf("Mod(%d, %d) = %d, %v, want %d, %v", tt.x, tt.y, got, err, tt.want, tt.err)
+		}
+	}
+}
+
+func TestPow(t *testing.T) {
+	tests := []struct {
+		base, exp, want int64
+		err             error
+	}{
+		{0, 0, 1, nil},
+		{0, 5, 0, nil},
+		{2, 10, 1024, nil},
+		{2, 62, 1 << 62, nil},
+		{2, 63, 0, ErrOverflow},
+		{-2, 63, math.MinInt64, nil},
+		{-2, 64, 0, ErrOverflow},
+		{3, 39, 4052555153018976267, nil},
+		{3, 40, 0, ErrOverflow},
+		{10, 18, 1e18, nil},
+		{10, 19, 0, ErrOverflow},
+		{3037000499, 2, 9223372030926249001, nil},
+		{3037000500, 2, 0, ErrOverflow},
+		{-1, math.MaxInt64, -1, nil},
+		{-1, math.MaxInt64 - 1, 1, nil},
+		{1, math.MaxInt64, 1, nil},
+		{2, math.MaxInt64, 0, ErrOverflow},
+		{math.MinInt64, 1, math.MinInt64, nil},
+		{math.MinInt64, 2, 0, ErrOverflow},
+		{2, -1, 0, ErrNegativeExponent},
+	}
+	for _, tt := range tests {
+		got, err := Pow(tt.base, tt.exp)
+		if err != tt.err || got != tt.want {
+			t.Errorf("Pow(%d, %d) = %d, %v, want %d, %v", tt.base, tt.exp, got, err, tt.want, tt.err)
+		}
+	}
+}
@@ -0,0 +1,197 @@
+package expr
+
+import (
+	"fmt"
+	"math"
+)
+
+// evaluator holds the state of an evaluation. Every node takes constant time,
+// so MaxLength bounds the work of an evaluation too.
+type evaluator struct {
+	vars map[string]float64
+}
+
+type node interface {
+	eval(e *evaluator) (float64, error)
+}
+
+// Eval evaluates the expression with the values of vars. Operations whose
+// result is not a finite number, e.g. a division by zero, fail with an Error
+// at the position of the operation.
+func (x *Expr) Eval(vars map[string]float64) (float64, error) {
+	return x.root.eval(&evaluator{vars: vars})
+}
+
+// Evaluate parses and evaluates the expression s with the values of vars.
+func Evaluate(s string, vars map[string]float64) (float64, error) {
+	x, err := Parse(s)
+	if err != nil {
+		return 0, err
+	}
+	return x.Eval(vars)
+}
+
+// finite fails at pos when v is NaN or infinite.
+func finite(pos int, v float64, what string) (float64, error) {
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return 0, errorf(pos, "%s is not a finite number", what)
+	}
+	return v, nil
+}
+
+type number struct {
+	value float64
+}
+
+func (n *number) eval(e *evaluator) (float64, error) {
+	return n.value, nil
+}
+
+type variable struct {
+	pos  int
+	name string
+}
+
+func (v *variable) eval(e *evaluator) (float64, error) {
+	value, ok := e.vars[v.name]
+	if !ok {
+		return 0, errorf(v.pos, "undefined variable %q", v.name)
+	}
+	return finite(v.pos, value, fmt.Sprintf("variable %q", v.name))
+}
+
+type negate struct {
+	pos     int
+	operand node
+}
+
+func (n *negate) eval(e *evaluator) (float64, error) {
+	v, err := n.operand.eval(e)
+	if err != nil {
+		return 0, err
+	}
+	return -v, nil
+}
+
+type binary struct {
+	pos         int
+	op          byte
+	left, right node
+}
+
+func (b *binary) eval(e *evaluator) (float64, error) {
+	l, err := b.left.eval(e)
+	if err != nil {
+		return 0, err
+	}
+	r, err := b.right.eval(e)
+	if err != nil {
+		return 0, err
+	}
+	var v float64
+	switch b.op {
+	case '+':
+		v = l + r
+	case '-':
+		v = l - r
+	case '*':
+		v = l * r
+	case '/', '%':
+		if r == 0 {
+			return 0, errorf(b.pos, "division by zero")
+		}
+		if b.op == '/' {
+			v = l / r
+		} else {
+			v = math.Mod(l, r)
+		}
+	case '^':
+		v = math.Pow(l, r)
+	}
+	return finite(b.pos, v, fmt.Sprintf("result of %q", b.op))
+}
+
+// function is a built-in function taking between minArgs and maxArgs
+// arguments, maxArgs being -1 for no limit.
+type function struct {
+	minArgs, maxArgs int
+	apply            func(args []float64) (float64, error)
+}
+
+func (f function) arity() string {
+	switch {
+	case f.minArgs == f.maxArgs && f.minArgs == 1:
+		return "1 argument"
+	case f.minArgs == f.maxArgs:
+		return fmt.Sprintf("%d arguments", f.minArgs)
+	case f.maxArgs < 0:
+		return fmt.Sprintf("at least %d arguments", f.minArgs)
+	default:
+		return fmt.Sprintf("%d to %d arguments", f.minArgs, f.maxArgs)
+	}
+}
+
+var functions = map[string]function{
+	"sqrt": {1, 1, func(args []float64) (float64, error) {
+		if args[0] < 0 {
+			return 0, fmt.Errorf("square root of negative number %v", args[0])
+		}
+		return math.Sqrt(args[0]), nil
+	}},
+	"pow": {2, 2, func(args []float64) (float64, error) {
+		return math.Pow(args[0], args[1]), nil
+	}},
+	"abs": {1, 1, func(args []float64) (float64, error) {
+		return math.Abs(args[0]), nil
+	}},
+	"min": {1, -1, func(args []float64) (float64, error) {
+		m := args[0]
+		for _, a := range args[1:] {
+			m = math.Min(m, a)
+		}
+		return m, nil
+	}},
+	"max": {1, -1, func(args []float64) (float64, error) {
+		m := args[0]
+		for _, a := range args[1:] {
+			m = math.Max(m, a)
+		}
+		return m, nil
+	}},
+	// log(x) is the natural logarithm, log(x, b) the logarithm in base b.
+	"log": {1, 2, func(args []float64) (float64, error) {
+		if args[0] <= 0 {
+			return 0, fmt.Errorf("logarithm of non-positive number %v", args[0])
+		}
+		if len(args) == 1 {
+			return math.Log(args[0]), nil
+		}
+		if args[1] <= 0 || args[1] == 1 {
+			return 0, fmt.Errorf("invalid logarithm base %v", args[1])
+		}
+		return math.Log(args[0]) / math.Log(args[1]), nil
+	}},
+}
+
+type call struct {
+	pos  int
+	name string
+	fn   function
+	args []node
+}
+
+func (c *call) eval(e *evaluator) (float64, error) {
+	args := make([]float64, len(c.args))
+	for i, arg := range c.args {
+		v, err := arg.eval(e)
+		if err != nil {
+			return 0, err
+		}
+		args[i] = v
+	}
+	v, err := c.fn.apply(args)
+	if err != nil {
+		return 0, errorf(c.pos, "%s: %v", c.name, err)
+	}
+	return finite(c.pos, v, fmt.Sprintf("result of %s", c.name))
+}
@@ -0,0 +1,129 @@
+package expr
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestEvaluate(t *testing.T) {
+	vars := map[string]float64{"x": 3, "y": 4, "_z9": -2}
+	tests := []struct {
+		expr string
+		want float64
+	}{
+		{"42", 42},
+		{".5", 0.5},
+		{"1e3", 1000},
+		{"2.5E-1", 0.25},
+		{" 1 +\t2\n", 3},
+		{"1 - 2 - 3", -4},
+		{"2 * 3 + 4", 10},
+		{"2 + 3 * 4", 14},
+		{"(2 + 3) * 4", 20},
+		{"8 / 2 / 2", 2},
+		{"7 % 3", 1},
+		{"-7 % 3", -1},
+		{"7.5 % 2", 1.5},
+		{"2 ^ 3 ^ 2", 512},
+		{"(2 ^ 3) ^ 2", 64},
+		{"-2 ^ 2", -4},
+		{"(-2) ^ 2", 4},
+		{"2 ^ -1", 0.5},
+		{"--3", 3},
+		{"+-+3", -3},
+		{"x * y - _z9", 14},
+		{"sqrt(x*x + y*y)", 5},
+		{"pow(2, 10)", 1024},
+		{"abs(_z9)", 2},
+		{"min(3)", 3},
+		{"min(x, y, _z9, 7)", -2},
+		{"max(x, y, _z9, 7)", 7},
+		{"log(1)", 0},
+		{"log(8, 2)", 3},
+		{"max(sqrt(16), pow(x, 2)) / (1 + 1)", 4.5},
+	}
+	for _, tt := range tests {
+		got, err := Evaluate(tt.expr, vars)
+		if err != nil || math.Abs(got-tt.want) > 1e-12 {
+			t.Errorf("Evaluate(%q) = %v, %v, want %v", tt.expr, got, err, tt.want)
+		}
+	}
+}
+
+func TestEvaluateErrors(t *testing.T) {
+	vars := map[string]float64{"x": 3, "nan": math.NaN(), "inf": math.Inf(1)}
+	tests := []struct {
+		expr string
+		pos  int
+		msg  string
+	}{
+		{"", 1, "unexpected end of expression"},
+		{"1 +", 4, "unexpected end of expression"},
+		{"2 $ 3", 3, "unexpected character"},
+		{"1.2.3", 1, "malformed number"},
+		{"1e", 2, `unexpected "e"`},
+		{"(1 + 2", 7, `expected ")"`},
+		{"1 + 2)", 6, `unexpected ")"`},
+		{"1 2", 3, `unexpected "2"`},
+		{"* 2", 1, `unexpected "*"`},
+		{"y + 1", 1, `undefined variable "y"`},
+		{"1 + nan", 5, "not a finite number"},
+		{"inf", 1, "not a finite number"},
+		{"x / 0", 3, "division by zero"},
+		{"x % (x - 3)", 3, "division by zero"},
+		{"1e308 * 10", 7, "not a finite number"},
+		{"(-8) ^ 0.5", 6, "not a finite number"},
+		{"sqrt(-1)", 1, "square root of negative number"},
+		{"log(0)", 1, "logarithm of non-positive number"},
+		{"log(8, 1)", 1, "invalid logarithm base"},
+		{"1 + foo(1)", 5, `unknown function "foo"`},
+		{"min()", 1, "at least 1 arguments"},
+		{"sqrt(1, 2)", 1, "1 argument"},
+		{"pow(1)", 1, "2 arguments"},
+		{"log(1, 2, 3)", 1, "1 to 2 arguments"},
+		{"max(1,)", 7, `unexpected ")"`},
+		{strings.Repeat("(", MaxDepth+1) + "1" + strings.Repeat(")", MaxDepth+1), MaxDepth + 1, "nested deeper"},
+		{strings.Repeat("-", MaxDepth+1) + "1", MaxDepth + 1, "nested deeper"},
+		{strings.Repeat("2^", MaxDepth+1) + "1", 2*MaxDepth + 2, "nested deeper"},
+		{strings.Repeat("1", MaxLength+1), MaxLength + 1, "longer than"},
+	}
+	for _, tt := range tests {
+		_, err := Evaluate(tt.expr, vars)
+		e, ok := err.(*Error)
+		if !ok || e.Pos != tt.pos || !strings.Contains(e.Msg, tt.msg) {
+			t.Errorf("Evaluate(%.20q) = %v, want an error at position %d containing %q", tt.expr, err, tt.pos, tt.msg)
+		}
+	}
+}
+
+func TestNestingLimit(t *testing.T) {
+	tests := []string{
+		strings.Repeat("(", MaxDepth) + "1" + strings.Repeat(")", MaxDepth),
+		strings.Repeat("-", MaxDepth) + "1",
+		strings.Repeat("1+", MaxLength/2-1) + "1",
+	}
+	for _, s := range tests {
+		if _, err := Evaluate(s, nil); err != nil {
+			t.Errorf("Evaluate(%.20q) = %v, want no error", s, err)
+		}
+	}
+}
+
+func TestParseOnce(t *testing.T) {
+	x, err := Parse("a * b + 1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tt := range []struct {
+		a, b, want float64
+	}{
+		{1, 2, 3},
+		{-3, 4, -11},
+	} {
+		got, err := x.Eval(map[string]float64{"a": tt.a, "b": tt.b})
+		if err != nil || got != tt.want {
+			t.Errorf("Eval(a=%v, b=%v) = %v, %v, want %v", tt.a, tt.b, got, err, tt.want)
+		}
+	}
+}
@@ -0,0 +1,330 @@
+// Package expr parses and evaluates the infix arithmetic expressions of the
+// Evaluate RPC, e.g. "(x + y) * sqrt(z)".
+//
+// The grammar, from the lowest to the highest precedence, is
+//
+//	expr    = term { ("+" | "-") term }
+//	term    = unary { ("*" | "/" | "%") unary }
+//	unary   = ("+" | "-") unary | power
+//	power   = primary [ "^" unary ]
+//	primary = number | name | name "(" [ expr { "," expr } ] ")" | "(" expr ")"
+//
+// so "^" is right associative and binds tighter than the unary minus:
+// "-2^2" is -4.
+package expr
+
+import (
+	"fmt"
+	"strconv"
+)
+
+const (
+	// MaxLength is the length of the longest expression accepted, in bytes.
+	MaxLength = 1024
+	// MaxDepth is the deepest nesting of parentheses, calls and unary
+	// operators accepted.
+	MaxDepth = 64
+)
+
+// Error is a syntax or evaluation error at a position of the expression.
+type Error struct {
+	// Pos is the 1-based column of the offending token.
+	Pos int
+	Msg string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("position %d: %s", e.Pos, e.Msg)
+}
+
+func errorf(pos int, format string, args ...interface{}) error {
+	return &Error{Pos: pos + 1, Msg: fmt.Sprintf(format, args...)}
+}
+
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	tokNumber
+	tokName
+	tokOp
+)
+
+type token struct {
+	kind tokenKind
+	pos  int
+	text string
+}
+
+func (t token) String() string {
+	if t.kind == tokEOF {
+		return "end of expression"
+	}
+	return strconv.Quote(t.text)
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+func isNameStart(c byte) bool {
+	return c == '_' || 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z'
+}
+
+func lex(s string) ([]token, error) {
+	var toks []token
+	for i := 0; i < len(s); {
+		c := s[i]
+		start := i
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+			continue
+		case isDigit(c) || c == '.':
+			for i < len(s) && (isDigit(s[i]) || s[i] == '.') {
+				i++
+			}
+			if i < len(s) && (s[i] == 'e' || s[i] == 'E') {
+				j := i + 1
+				if j < len(s) && (s[j] == '+' || s[j] == '-') {
+					j++
+				}
+				if j < len(s) && isDigit(s[j]) {
+					for i = j; i < len(s) && isDigit(s[i]); i++ {
+					}
+				}
+			}
+			toks = append(toks, token{tokNumber, start, s[start:i]})
+		case isNameStart(c):
+			for i < len(s) && (isNameStart(s[i]) || isDigit(s[i])) {
+				i++
+			}
+			toks = append(toks, token{tokName, start, s[start:i]})
+		case c == '+' || c == '-' || c == '*' || c == '/' || c == '%' || c == '^' || c == '(' || c == ')' || c == ',':
+			i++
+			toks = append(toks, token{tokOp, start, s[start:i]})
+		default:
+			return nil, errorf(start, "unexpected character %q", c)
+		}
+	}
+	return append(toks, token{tokEOF, len(s), ""}), nil
+}
+
+// Expr is a parsed expression.
+type Expr struct {
+	root node
+}
+
+// Parse parses the expression s.
+func Parse(s string) (*Expr, error) {
+	if len(s) > MaxLength {
+		return nil, errorf(MaxLength, "expression is longer than %d bytes", MaxLength)
+	}
+	toks, err := lex(s)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{toks: toks}
+	root, err := p.expr()
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.kind != tokEOF {
+		return nil, errorf(t.pos, "unexpected %v", t)
+	}
+	return &Expr{root: root}, nil
+}
+
+type parser struct {
+	toks  []token
+	next  int
+	depth int
+}
+
+func (p *parser) peek() token {
+	return p.toks[p.next]
+}
+
+func (p *parser) advance() token {
+	t := p.toks[p.next]
+	if t.kind != tokEOF {
+		p.next++
+	}
+	return t
+}
+
+// accept consumes the next token if it is one of the operators ops.
+func (p *parser) accept(ops ...string) (token, bool) {
+	t := p.peek()
+	if t.kind != tokOp {
+		return t, false
+	}
+	for _, op := range ops {
+		if t.text == op {
+			return p.advance(), true
+		}
+	}
+	return t, false
+}
+
+func (p *parser) expect(op string) error {
+	if t, ok := p.accept(op); !ok {
+		return errorf(t.pos, "expected %q, found %v", op, t)
+	}
+	return nil
+}
+
+// enter bounds the recursion of the parser and so the depth of the tree.
+func (p *parser) enter(pos int) error {
+	p.depth++
+	if p.depth > MaxDepth {
+		return errorf(pos, "expression is nested deeper than %d levels", MaxDepth)
+	}
+	return nil
+}
+
+func (p *parser) leave() {
+	p.depth--
+}
+
+func (p *parser) expr() (node, error) {
+	left, err := p.term()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		t, ok := p.accept("+", "-")
+		if !ok {
+			return left, nil
+		}
+		right, err := p.term()
+		if err != nil {
+			return nil, err
+		}
+		left = &binary{pos: t.pos, op: t.text[0], left: left, right: right}
+	}
+}
+
+func (p *parser) term() (node, error) {
+	left, err := p.unary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		t, ok := p.accept("*", "/", "%")
+		if !ok {
+			return left, nil
+		}
+		right, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		left = &binary{pos: t.pos, op: t.text[0], left: left, right: right}
+	}
+}
+
+func (p *parser) unary() (node, error) {
+	t, ok := p.accept("+", "-")
+	if !ok {
+		return p.power()
+	}
+	if err := p.enter(t.pos); err != nil {
+		return nil, err
+	}
+	defer p.leave()
+	operand, err := p.unary()
+	if err != nil {
+		return nil, err
+	}
+	if t.text == "+" {
+		return operand, nil
+	}
+	return &negate{pos: t.pos, operand: operand}, nil
+}
+
+func (p *parser) power() (node, error) {
+	base, err := p.primary()
+	if err != nil {
+		return nil, err
+	}
+	t, ok := p.accept("^")
+	if !ok {
+		return base, nil
+	}
+	if err := p.enter(t.pos); err != nil {
+		return nil, err
+	}
+	defer p.leave()
+	exp, err := p.unary()
+	if err != nil {
+		return nil, err
+	}
+	return &binary{pos: t.pos, op: '^', left: base, right: exp}, nil
+}
+
+func (p *parser) primary() (node, error) {
+	t := p.advance()
+	switch t.kind {
+	case tokNumber:
+		v, err := strconv.ParseFloat(t.text, 64)
+		if err != nil {
+			return nil, errorf(t.pos, "malformed number %v", t)
+		}
+		return &number{value: v}, nil
+	case tokName:
+		if _, ok := p.accept("("); ok {
+			return p.call(t)
+		}
+		return &variable{pos: t.pos, name: t.text}, nil
+	case tokOp:
+		if t.text == "(" {
+			if err := p.enter(t.pos); err != nil {
+				return nil, err
+			}
+			defer p.leave()
+			n, err := p.expr()
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expect(")"); err != nil {
+				return nil, err
+			}
+			return n, nil
+		}
+	}
+	return nil, errorf(t.pos, "unexpected %v", t)
+}
+
+// call parses the arguments of a call to the function name, whose opening
+// parenthesis was consumed.
+func (p *parser) call(name token) (node, error) {
+	fn, ok := functions[name.text]
+	if !ok {
+		return nil, errorf(name.pos, "unknown function %q", name.text)
+	}
+	if err := p.enter(name.pos); err != nil {
+		return nil, err
+	}
+	defer p.leave()
+
+	c := &call{pos: name.pos, name: name.text, fn: fn}
+	if _, ok := p.accept(")"); !ok {
+		for {
+			arg, err := p.expr()
+			if err != nil {
+				return nil, err
+			}
+			c.args = append(c.args, arg)
+			if _, ok := p.accept(","); !ok {
+				break
+			}
+		}
+		if err := p.expect(")"); err != nil {
+			return nil, err
+		}
+	}
+	if len(c.args) < fn.minArgs || fn.maxArgs >= 0 && len(c.args) > fn.maxArgs {
+		return nil, errorf(name.pos, "%s expects %s, got %d", name.text, fn.arity(), len(c.args))
+	}
+	return c, nil
+}
@@ -0,0 +1,86 @@
+package numtheory
+
+import (
+	"context"
+	"math/bits"
+)
+
+// mulMod returns a*b mod m without overflowing.
+func mulMod(a, b, m uint64) uint64 {
+	hi, lo := bits.Mul64(a, b)
+	return bits.Rem64(hi, lo, m)
+}
+
+// ModPow returns b**e mod m, for m > 0.
+func ModPow(b, e, m uint64) uint64 {
+	if m == 1 {
+		return 0
+	}
+	r := uint64(1)
+	b %= m
+	for e > 0 {
+		if e&1 == 1 {
+			r = mulMod(r, b, m)
+		}
+		b = mulMod(b, b, m)
+		e >>= 1
+	}
+	return r
+}
+
+// GCD returns the greatest common divisor of a and b, 0 when both are 0.
+func GCD(a, b uint64) uint64 {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
+
+// LCM returns the least common multiple of a and b, 0 when either is 0, and
+// false when it overflows.
+func LCM(a, b uint64) (uint64, bool) {
+	if a == 0 || b == 0 {
+		return 0, true
+	}
+	hi, lo := bits.Mul64(a/GCD(a, b), b)
+	return lo, hi == 0
+}
+
+// ModInverse returns the x in [0, m) with a*x = 1 mod m, for m > 0, and
+// false when a and m are not coprime.
+func ModInverse(a, m uint64) (uint64, bool) {
+	if m == 1 {
+		return 0, true
+	}
+	// Extended Euclid, tracking the coefficients of a modulo m so they stay
+	// unsigned.
+	r0, r1 := m, a%m
+	t0, t1 := uint64(0), uint64(1)
+	for r1 != 0 {
+		q := r0 / r1
+		r0, r1 = r1, r0-q*r1
+		t0, t1 = t1, (t0+m-mulMod(q, t1, m))%m
+	}
+	if r0 != 1 {
+		return 0, false
+	}
+	return t0, true
+}
+
+// Totient returns Euler's totient of n > 0, the count of the numbers in
+// [1, n] coprime to n, factoring n with the budget of Factor.
+func Totient(ctx context.Context, n uint64, maxSteps int) (uint64, error) {
+	phi := n
+	var last uint64
+	err := Factor(ctx, n, maxSteps, func(p uint64) error {
+		if p != last {
+			phi = phi / p * (p - 1)
+			last = p
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return phi, nil
+}
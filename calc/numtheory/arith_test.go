@@ -0,0 +1,112 @@
+package numtheory
+
+import (
+	"context"
+	"math"
+	"testing"
+)
+
+func TestModPow(t *testing.T) {
+	tests := []struct {
+		b, e, m, want uint64
+	}{
+		{2, 10, 1000, 24},
+		{5, 0, 7, 1},
+		{5, 3, 1, 0},
+		{0, 0, 7, 1},
+		{math.MaxUint64, 2, math.MaxUint64 - 1, 1},
+		{2, 62, math.MaxInt64, 1 << 62},
+		{2, 63, math.MaxInt64, 1},
+		// Fermat's little theorem.
+		{3, 9223372036854775782, 9223372036854775783, 1},
+	}
+	for _, tt := range tests {
+		if got := ModPow(tt.b, tt.e, tt.m); got != tt.want {
+			t.Errorf("ModPow(%d, %d, %d) = %d, want %d", tt.b, tt.e, tt.m, got, tt.want)
+		}
+	}
+}
+
+func TestGCD(t *testing.T) {
+	tests := []struct {
+		a, b, want uint64
+	}{
+		{0, 0, 0},
+		{0, 5, 5},
+		{5, 0, 5},
+		{12, 18, 6},
+		{17, 5, 1},
+		{math.MaxUint64, math.MaxUint64 / 3, math.MaxUint64 / 3},
+		{1 << 63, 1 << 40, 1 << 40},
+	}
+	for _, tt := range tests {
+		if got := GCD(tt.a, tt.b); got != tt.want {
+			t.Errorf("GCD(%d, %d) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestLCM(t *testing.T) {
+	tests := []struct {
+		a, b, want uint64
+		ok         bool
+	}{
+		{0, 5, 0, true},
+		{4, 6, 12, true},
+		{1 << 32, 1 << 31, 1 << 32, true},
+		{1 << 32, 1<<32 - 1, math.MaxUint64 - 1<<32 + 1, true},
+		{1 << 32, 1<<32 + 1, 0, false},
+		{math.MaxUint64, 2, 0, false},
+	}
+	for _, tt := range tests {
+		got, ok := LCM(tt.a, tt.b)
+		if ok != tt.ok || ok && got != tt.want {
+			t.Errorf("LCM(%d, %d) = %d, %v, want %d, %v", tt.a, tt.b, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestModInverse(t *testing.T) {
+	tests := []struct {
+		a, m, want uint64
+		ok         bool
+	}{
+		{3, 7, 5, true},
+		{10, 7, 5, true},
+		{0, 1, 0, true},
+		{5, 1, 0, true},
+		{0, 7, 0, false},
+		{6, 9, 0, false},
+		{2, math.MaxUint64, 1 << 63, true},
+		{math.MaxUint64 - 1, math.MaxUint64, math.MaxUint64 - 1, true},
+	}
+	for _, tt := range tests {
+		got, ok := ModInverse(tt.a, tt.m)
+		if ok != tt.ok || ok && got != tt.want {
+			t.Errorf("ModInverse(%d, %d) = %d, %v, want %d, %v", tt.a, tt.m, got, ok, tt.want, tt.ok)
+		}
+		if ok && tt.m > 1 && mulMod(tt.a, got, tt.m) != 1 {
+			t.Errorf("ModInverse(%d, %d) = %d is not an inverse", tt.a, tt.m, got)
+		}
+	}
+}
+
+func TestTotient(t *testing.T) {
+	tests := []struct {
+		n, want uint64
+	}{
+		{1, 1},
+		{2, 1},
+		{36, 12},
+		{9223372036854775783, 9223372036854775782},
+		{1 << 63, 1 << 62},
+		{3037000453 * 3037000493, 3037000452 * 3037000492},
+		{math.MaxInt64, 6 * 7 * 72 * 126 * 336 * 92736 * 649656},
+	}
+	for _, tt := range tests {
+		got, err := Totient(context.Background(), tt.n, maxSteps)
+		if err != nil || got != tt.want {
+			t.Errorf("Totient(%d) = %d, %v, want %d", tt.n, got, err, tt.want)
+		}
+	}
+}
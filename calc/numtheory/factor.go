@@ -0,0 +1,158 @@
+package numtheory
+
+import (
+	"context"
+	"errors"
+	"sort"
+)
+
+// ErrBudgetExhausted is returned by Factor when the factorization needs more
+// steps than its budget allows.
+var ErrBudgetExhausted = errors.New("compute budget exhausted")
+
+// wheelLimit bounds the trial division. The factors left once it is reached
+// are above wheelLimit and found by Pollard's rho.
+const wheelLimit = 1 << 12
+
+// wheel holds the gaps between the numbers coprime to 2, 3 and 5, starting
+// from 7.
+var wheel = [...]uint64{4, 2, 4, 2, 4, 6, 2, 6}
+
+// budget counts the steps left to a factorization and stops it once its
+// context is done.
+type budget struct {
+	ctx   context.Context
+	steps int
+}
+
+func (b *budget) spend(steps int) error {
+	if err := b.ctx.Err(); err != nil {
+		return err
+	}
+	b.steps -= steps
+	if b.steps < 0 {
+		return ErrBudgetExhausted
+	}
+	return nil
+}
+
+// Factor calls emit with the prime factors of n in increasing order, each
+// repeated as many times as it divides n. It gives up with ctx.Err() once ctx
+// is done, with ErrBudgetExhausted after maxSteps trial divisions and rho
+// iterations, and with the error of emit if emit fails.
+func Factor(ctx context.Context, n uint64, maxSteps int, emit func(p uint64) error) error {
+	b := &budget{ctx: ctx, steps: maxSteps}
+
+	for _, p := range []uint64{2, 3, 5} {
+		for n%p == 0 {
+			if err := emit(p); err != nil {
+				return err
+			}
+			n /= p
+		}
+	}
+	for d, i := uint64(7), 0; d < wheelLimit && d*d <= n; d, i = d+wheel[i], (i+1)%len(wheel) {
+		for n%d == 0 {
+			if err := emit(d); err != nil {
+				return err
+			}
+			n /= d
+		}
+		if i == 0 {
+			if err := b.spend(len(wheel)); err != nil {
+				return err
+			}
+		}
+	}
+	if n == 1 {
+		return nil
+	}
+
+	factors, err := factorLarge(b, n)
+	if err != nil {
+		return err
+	}
+	sort.Slice(factors, func(i, j int) bool { return factors[i] < factors[j] })
+	for _, p := range factors {
+		if err := b.spend(0); err != nil {
+			return err
+		}
+		if err := emit(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// factorLarge returns the prime factors of n, which has no factor below
+// wheelLimit.
+func factorLarge(b *budget, n uint64) ([]uint64, error) {
+	if IsPrime(n) {
+		return []uint64{n}, nil
+	}
+	d, err := rho(b, n)
+	if err != nil {
+		return nil, err
+	}
+	left, err := factorLarge(b, d)
+	if err != nil {
+		return nil, err
+	}
+	right, err := factorLarge(b, n/d)
+	if err != nil {
+		return nil, err
+	}
+	return append(left, right...), nil
+}
+
+// rho returns a non-trivial divisor of the odd composite n, using Brent's
+// variant of Pollard's rho with the gcds batched over 128 iterations.
+func rho(b *budget, n uint64) (uint64, error) {
+	const batch = 128
+	for c := uint64(1); ; c++ {
+		f := func(x uint64) uint64 { return (mulMod(x, x, n) + c) % n }
+		y, q, g := uint64(2), uint64(1), uint64(1)
+		var x, ys uint64
+		for r := 1; g == 1; r *= 2 {
+			x = y
+			for i := 0; i < r; i++ {
+				y = f(y)
+			}
+			if err := b.spend(r); err != nil {
+				return 0, err
+			}
+			for k := 0; k < r && g == 1; k += batch {
+				ys = y
+				m := r - k
+				if m > batch {
+					m = batch
+				}
+				for i := 0; i < m; i++ {
+					y = f(y)
+					q = mulMod(q, absDiff(x, y), n)
+				}
+				g = GCD(q, n)
+				if err := b.spend(m); err != nil {
+					return 0, err
+				}
+			}
+		}
+		if g == n {
+			// The batch overshot: replay it one step at a time.
+			for g = 1; g == 1; {
+				ys = f(ys)
+				g = GCD(absDiff(x, ys), n)
+			}
+		}
+		if g != n {
+			return g, nil
+		}
+	}
+}
+
+func absDiff(a, b uint64) uint64 {
+	if a > b {
+		return a - b
+	}
+	return b - a
+}
@@ -0,0 +1,116 @@
+package numtheory
+
+import (
+	"context"
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+)
+
+// maxSteps is a budget no test number needs.
+const maxSteps = 1 << 24
+
+func factors(t *testing.T, n uint64) []uint64 {
+	t.Helper()
+	var got []uint64
+	if err := Factor(context.Background(), n, maxSteps, func(p uint64) error {
+		got = append(got, p)
+		return nil
+	}); err != nil {
+		t.Fatalf("Factor(%d) failed: %v", n, err)
+	}
+	return got
+}
+
+func TestFactor(t *testing.T) {
+	twos := make([]uint64, 63)
+	for i := range twos {
+		twos[i] = 2
+	}
+	tests := []struct {
+		n    uint64
+		want []uint64
+	}{
+		{1, nil},
+		{2, []uint64{2}},
+		{360, []uint64{2, 2, 2, 3, 3, 5}},
+		{4093 * 4093, []uint64{4093, 4093}}, // square of the largest prime below wheelLimit
+		{4099 * 4099, []uint64{4099, 4099}}, // square of the smallest prime above it
+		{65537 * 65539, []uint64{65537, 65539}},
+		{2147483647 * 4294967291, []uint64{2147483647, 4294967291}},
+		{3037000453 * 3037000493, []uint64{3037000453, 3037000493}},
+		{9223372036854775783, []uint64{9223372036854775783}},
+		{math.MaxInt64, []uint64{7, 7, 73, 127, 337, 92737, 649657}},
+		{1 << 63, twos},
+		{math.MaxUint64, []uint64{3, 5, 17, 257, 641, 65537, 6700417}},
+	}
+	for _, tt := range tests {
+		if got := factors(t, tt.n); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Factor(%d) = %v, want %v", tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestFactorProduct(t *testing.T) {
+	for n := uint64(2); n < 5000; n++ {
+		prod, last := uint64(1), uint64(0)
+		for _, p := range factors(t, n) {
+			if p < last || !IsPrime(p) {
+				t.Fatalf("Factor(%d) emitted %d after %d", n, p, last)
+			}
+			prod, last = prod*p, p
+		}
+		if prod != n {
+			t.Errorf("the factors of %d multiply to %d", n, prod)
+		}
+	}
+}
+
+func TestFactorErrors(t *testing.T) {
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	errStop := errors.New("stop")
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		n        uint64
+		maxSteps int
+		emit     func(uint64) error
+		want     error
+	}{
+		{"budget of the trial division", context.Background(), 4093 * 4093, 10, nil, ErrBudgetExhausted},
+		{"budget of rho", context.Background(), 3037000453 * 3037000493, 2000, nil, ErrBudgetExhausted},
+		{"canceled", canceled, 3037000453 * 3037000493, maxSteps, nil, context.Canceled},
+		{"emit", context.Background(), 12, maxSteps, func(uint64) error { return errStop }, errStop},
+	}
+	for _, tt := range tests {
+		emit := tt.emit
+		if emit == nil {
+			emit = func(uint64) error { return nil }
+		}
+		if err := Factor(tt.ctx, tt.n, tt.maxSteps, emit); err != tt.want {
+			t.Errorf("%s: Factor(%d) = %v, want %v", tt.name, tt.n, err, tt.want)
+		}
+	}
+}
+
+func TestRho(t *testing.T) {
+	tests := []uint64{
+		65537 * 65539,
+		4099 * 4099 * 4099,
+		2147483647 * 4294967291,
+		3037000453 * 3037000493,
+		math.MaxUint64 / 15,
+	}
+	for _, n := range tests {
+		b := &budget{ctx: context.Background(), steps: maxSteps}
+		d, err := rho(b, n)
+		if err != nil {
+			t.Fatalf("rho(%d) failed: %v", n, err)
+		}
+		if d <= 1 || d >= n || n%d != 0 {
+			t.Errorf("rho(%d) = %d, not a non-trivial divisor", n, d)
+		}
+	}
+}
@@ -0,0 +1,47 @@
+// Package numtheory implements the number theory behind the Calculator
+// service on unsigned 64-bit integers.
+package numtheory
+
+import "math/bits"
+
+// millerRabinBases make the Miller-Rabin test deterministic for every n
+// below 2^64.
+var millerRabinBases = []uint64{2, 3, 5, 7, 11, 13, 17, 19, 23, 29, 31, 37}
+
+// IsPrime reports whether n is prime, using the deterministic Miller-Rabin
+// test.
+func IsPrime(n uint64) bool {
+	if n < 2 {
+		return false
+	}
+	for _, p := range millerRabinBases {
+		if n%p == 0 {
+			return n == p
+		}
+	}
+	d := n - 1
+	s := bits.TrailingZeros64(d)
+	d >>= uint(s)
+	for _, a := range millerRabinBases {
+		if !strongProbablePrime(n, a, d, s) {
+			return false
+		}
+	}
+	return true
+}
+
+// strongProbablePrime reports whether n, with n-1 = d*2^s, passes the
+// Miller-Rabin round for base a.
+func strongProbablePrime(n, a, d uint64, s int) bool {
+	x := ModPow(a, d, n)
+	if x == 1 || x == n-1 {
+		return true
+	}
+	for i := 1; i < s; i++ {
+		x = mulMod(x, x, n)
+		if x == n-1 {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,57 @@
+package numtheory
+
+import (
+	"math"
+	"testing"
+)
+
+// trialPrime reports whether n is prime by trial division.
+func trialPrime(n uint64) bool {
+	if n < 2 {
+		return false
+	}
+	for d := uint64(2); d*d <= n; d++ {
+		if n%d == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func TestIsPrime(t *testing.T) {
+	tests := []struct {
+		n    uint64
+		want bool
+	}{
+		{0, false},
+		{1, false},
+		{2, true},
+		{37, true},
+		{41, true},
+		{561, false},  // Carmichael number
+		{2047, false}, // strong pseudoprime to base 2
+		{3215031751, false},
+		{4294967291, true},
+		{65537 * 65539, false},
+		{3825123056546413051, false}, // strong pseudoprime to the bases up to 23
+		{3037000453 * 3037000493, false},
+		{9223372036854775783, true}, // largest prime below 2^63
+		{math.MaxInt64, false},
+		{1 << 63, false},
+		{18446744073709551557, true}, // largest prime below 2^64
+		{math.MaxUint64, false},
+	}
+	for _, tt := range tests {
+		if got := IsPrime(tt.n); got != tt.want {
+			t.Errorf("IsPrime(%d) = %v, want %v", tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestIsPrimeSmall(t *testing.T) {
+	for n := uint64(0); n < 20000; n++ {
+		if got, want := IsPrime(n), trialPrime(n); got != want {
+			t.Errorf("IsPrime(%d) = %v, want %v", n, got, want)
+		}
+	}
+}
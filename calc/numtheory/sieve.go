@@ -0,0 +1,117 @@
+package numtheory
+
+import (
+	"context"
+	"errors"
+	"math"
+)
+
+const (
+	// MaxRange is the largest range of PrimesInRange.
+	MaxRange = 1 << 24
+	// segmentSize is the number of odd numbers sieved at once.
+	segmentSize = 1 << 15
+	// sieveLimit bounds the primes the segments are sieved with. The
+	// numbers left above sieveLimit² are checked with IsPrime instead.
+	sieveLimit = 1 << 16
+)
+
+// ErrRangeTooLarge is returned by PrimesInRange for ranges larger than
+// MaxRange.
+var ErrRangeTooLarge = errors.New("range too large")
+
+// smallPrimes returns the odd primes up to limit.
+func smallPrimes(limit uint64) []uint64 {
+	composite := make([]bool, limit+1)
+	var primes []uint64
+	for i := uint64(3); i <= limit; i += 2 {
+		if composite[i] {
+			continue
+		}
+		primes = append(primes, i)
+		for j := i * i; j <= limit; j += 2 * i {
+			composite[j] = true
+		}
+	}
+	return primes
+}
+
+// PrimesInRange calls emit with the primes in [from, to] in increasing
+// order, using a segmented sieve of Eratosthenes. It gives up with ctx.Err()
+// once ctx is done, checked after every segment, and with the error of emit
+// if emit fails.
+func PrimesInRange(ctx context.Context, from, to uint64, emit func(p uint64) error) error {
+	if to < from {
+		return nil
+	}
+	if to-from >= MaxRange {
+		return ErrRangeTooLarge
+	}
+	if from <= 2 && to >= 2 {
+		if err := emit(2); err != nil {
+			return err
+		}
+	}
+	if from < 3 {
+		from = 3
+	}
+	if from%2 == 0 {
+		from++
+	}
+	if from > to {
+		return nil
+	}
+
+	limit := uint64(math.Sqrt(float64(to))) + 1
+	if limit > sieveLimit {
+		limit = sieveLimit
+	}
+	primes := smallPrimes(limit)
+	// Above limit², a number surviving the sieve may still have a factor
+	// above limit.
+	checked := limit * limit
+
+	// composite[i] is set for the odd number lo + 2*i.
+	composite := make([]bool, segmentSize)
+	for lo := from; lo <= to; lo += 2 * segmentSize {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		n := uint64(segmentSize)
+		if span := (to-lo)/2 + 1; span < n {
+			n = span
+		}
+		for i := range composite[:n] {
+			composite[i] = false
+		}
+		for _, p := range primes {
+			if p*p > lo+2*(n-1) {
+				break
+			}
+			// The first odd multiple of p in the segment, skipping p.
+			start := (lo + p - 1) / p * p
+			if start < p*p {
+				start = p * p
+			}
+			if start%2 == 0 {
+				start += p
+			}
+			for j := (start - lo) / 2; j < n; j += p {
+				composite[j] = true
+			}
+		}
+		for i := uint64(0); i < n; i++ {
+			v := lo + 2*i
+			if composite[i] || v >= checked && !IsPrime(v) {
+				continue
+			}
+			if err := emit(v); err != nil {
+				return err
+			}
+		}
+		if to-lo < 2*segmentSize {
+			break
+		}
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+package numtheory
+
+import (
+	"context"
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+)
+
+// primesByTest returns the primes in [from, to] checked one by one.
+func primesByTest(from, to uint64) []uint64 {
+	var primes []uint64
+	for n := from; n <= to; n++ {
+		if IsPrime(n) {
+			primes = append(primes, n)
+		}
+		if n == to {
+			break
+		}
+	}
+	return primes
+}
+
+func TestPrimesInRange(t *testing.T) {
+	// A segment spans 2*segmentSize numbers.
+	const span = 2 * segmentSize
+	tests := []struct {
+		name     string
+		from, to uint64
+	}{
+		{"empty", 10, 1},
+		{"single prime", 7, 7},
+		{"single composite", 9, 9},
+		{"two", 2, 2},
+		{"start", 0, 100},
+		{"even bounds", 24, 90},
+		{"first segment edge", 3 + span - 50, 3 + span + 50},
+		{"several segments", 0, 3*span + 17},
+		{"segment edge above the sieve", 1<<32 + span - 50, 1<<32 + span + 50},
+		{"sieve limit", sieveLimit*sieveLimit - 3000, sieveLimit*sieveLimit + 3000},
+		{"square of the sieve limit", sieveLimit*sieveLimit - 1, sieveLimit*sieveLimit + 1},
+		{"semiprime above the sieve limit", 65537*65539 - 100, 65537*65539 + 100},
+		{"int64 limit", math.MaxInt64 - 2000, math.MaxInt64},
+		{"uint64 limit", math.MaxUint64 - 2000, math.MaxUint64},
+	}
+	for _, tt := range tests {
+		var got []uint64
+		err := PrimesInRange(context.Background(), tt.from, tt.to, func(p uint64) error {
+			got = append(got, p)
+			return nil
+		})
+		if err != nil {
+			t.Errorf("%s: PrimesInRange(%d, %d) failed: %v", tt.name, tt.from, tt.to, err)
+			continue
+		}
+		var want []uint64
+		if tt.from <= tt.to {
+			want = primesByTest(tt.from, tt.to)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("%s: PrimesInRange(%d, %d) = %d primes, want %d", tt.name, tt.from, tt.to, len(got), len(want))
+		}
+	}
+}
+
+func TestPrimesInRangeErrors(t *testing.T) {
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	errStop := errors.New("stop")
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		from, to uint64
+		emit     func(uint64) error
+		want     error
+	}{
+		{"largest range", context.Background(), 0, MaxRange - 1, nil, nil},
+		{"range too large", context.Background(), 0, MaxRange, nil, ErrRangeTooLarge},
+		{"whole uint64 range", context.Background(), 0, math.MaxUint64, nil, ErrRangeTooLarge},
+		{"canceled", canceled, 3, 100, nil, context.Canceled},
+		{"emit of two", context.Background(), 0, 100, func(uint64) error { return errStop }, errStop},
+		{"emit", context.Background(), 3, 100, func(uint64) error { return errStop }, errStop},
+	}
+	for _, tt := range tests {
+		emit := tt.emit
+		if emit == nil {
+			emit = func(uint64) error { return nil }
+		}
+		if err := PrimesInRange(tt.ctx, tt.from, tt.to, emit); err != tt.want {
+			t.Errorf("%s: PrimesInRange(%d, %d) = %v, want %v", tt.name, tt.from, tt.to, err, tt.want)
+		}
+	}
+}
@@ -0,0 +1,237 @@
+// Package session keeps the state of calculator sessions: named memory
+// registers and a bounded history of the operations. Sessions are created on
+// first use and evicted once idle for longer than a TTL.
+package session
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// MaxIDLength is the length of the longest session ID.
+	MaxIDLength = 128
+	// MaxRegisters is the number of registers of a session.
+	MaxRegisters = 64
+	// MaxNameLength is the length of the longest register name.
+	MaxNameLength = 64
+)
+
+var (
+	// ErrInvalidID is returned for empty or too long session IDs.
+	ErrInvalidID = errors.New("session IDs must have between 1 and 128 bytes")
+	// ErrTooManySessions is returned when a new session would exceed the
+	// limit of the store.
+	ErrTooManySessions = errors.New("too many sessions")
+	// ErrTooManyOwnerSessions is returned when a new session would exceed the
+	// limit of its owner.
+	ErrTooManyOwnerSessions = errors.New("too many sessions of the owner")
+	// ErrInvalidName is returned for register names that are not
+	// identifiers or are longer than MaxNameLength.
+	ErrInvalidName = errors.New("register names must be at most 64 letters, digits and underscores, not starting with a digit")
+	// ErrTooManyRegisters is returned when storing a new register would
+	// exceed MaxRegisters.
+	ErrTooManyRegisters = errors.New("too many registers")
+)
+
+var nameRe = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+var (
+	activeSessions = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "calc_sessions_active",
+		Help: "Number of calculator sessions in memory.",
+	})
+	evictedSessions = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "calc_sessions_evicted_total",
+		Help: "Number of calculator sessions evicted after their TTL.",
+	})
+)
+
+// Entry is an operation of the history of a session. Histories hold many
+// entries, so the request, response and error should be summaries kept
+// small by the caller.
+type Entry struct {
+	Time     time.Time
+	Method   string
+	Request  interface{}
+	Response interface{}
+	// RequestSize and ResponseSize are the sizes of the messages in bytes,
+	// set even when they are left out of the entry.
+	RequestSize, ResponseSize int
+	Err                       error
+}
+
+// Session is the state of a calculator session. It is safe for concurrent
+// use.
+type Session struct {
+	mu         sync.Mutex
+	registers  map[string]float64
+	history    []Entry
+	maxHistory int
+	owner      string
+	lastUsed   time.Time
+}
+
+// Store sets register name to v.
+func (s *Session) Store(name string, v float64) error {
+	if len(name) > MaxNameLength || !nameRe.MatchString(name) {
+		return ErrInvalidName
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.registers[name]; !ok && len(s.registers) >= MaxRegisters {
+		return ErrTooManyRegisters
+	}
+	s.registers[name] = v
+	return nil
+}
+
+// Recall returns the value of register name.
+func (s *Session) Recall(name string) (float64, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	v, ok := s.registers[name]
+	return v, ok
+}
+
+// Clear deletes register name, or every register when name is empty.
+func (s *Session) Clear(name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if name == "" {
+		s.registers = make(map[string]float64)
+		return
+	}
+	delete(s.registers, name)
+}
+
+// Registers returns a copy of the registers.
+func (s *Session) Registers() map[string]float64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	regs := make(map[string]float64, len(s.registers))
+	for name, v := range s.registers {
+		regs[name] = v
+	}
+	return regs
+}
+
+// Record appends e to the history, dropping the oldest entry once the
+// history is full.
+func (s *Session) Record(e Entry) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.history) >= s.maxHistory {
+		copy(s.history, s.history[1:])
+		s.history = s.history[:len(s.history)-1]
+	}
+	s.history = append(s.history, e)
+}
+
+// History returns the last limit entries of the history, oldest first, or
+// all of them when limit is 0.
+func (s *Session) History(limit int) []Entry {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	h := s.history
+	if limit > 0 && limit < len(h) {
+		h = h[len(h)-limit:]
+	}
+	return append([]Entry(nil), h...)
+}
+
+// Store holds the sessions by ID.
+type Store struct {
+	ttl         time.Duration
+	maxSessions int
+	maxPerOwner int
+	maxHistory  int
+
+	mu       sync.Mutex
+	sessions map[string]*Session
+	// owned counts the sessions by owner.
+	owned map[string]int
+}
+
+// NewStore returns a store of at most maxSessions sessions, and at most
+// maxPerOwner of them created by the same owner, so no owner can take them
+// all. Each session keeps the last maxHistory operations and is evicted after
+// ttl without use.
+func NewStore(ttl time.Duration, maxSessions, maxPerOwner, maxHistory int) *Store {
+	return &Store{
+		ttl:         ttl,
+		maxSessions: maxSessions,
+		maxPerOwner: maxPerOwner,
+		maxHistory:  maxHistory,
+		sessions:    make(map[string]*Session),
+		owned:       make(map[string]int),
+	}
+}
+
+// Get returns the session id, creating it on behalf of owner if needed, and
+// marks it used at now.
+func (st *Store) Get(id, owner string, now time.Time) (*Session, error) {
+	if id == "" || len(id) > MaxIDLength {
+		return nil, ErrInvalidID
+	}
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	s, ok := st.sessions[id]
+	if !ok {
+		if st.owned[owner] >= st.maxPerOwner {
+			return nil, ErrTooManyOwnerSessions
+		}
+		if len(st.sessions) >= st.maxSessions {
+			return nil, ErrTooManySessions
+		}
+		s = &Session{registers: make(map[string]float64), maxHistory: st.maxHistory, owner: owner}
+		st.sessions[id] = s
+		st.owned[owner]++
+		activeSessions.Inc()
+	}
+	s.lastUsed = now
+	return s, nil
+}
+
+// Evict removes the sessions idle for longer than the TTL at now and returns
+// how many it removed.
+func (st *Store) Evict(now time.Time) int {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	evicted := 0
+	for id, s := range st.sessions {
+		if now.Sub(s.lastUsed) > st.ttl {
+			delete(st.sessions, id)
+			if st.owned[s.owner]--; st.owned[s.owner] <= 0 {
+				delete(st.owned, s.owner)
+			}
+			evicted++
+		}
+	}
+	activeSessions.Sub(float64(evicted))
+	evictedSessions.Add(float64(evicted))
+	return evicted
+}
+
+// Run evicts the idle sessions every interval until ctx is done.
+func (st *Store) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			if n := st.Evict(now); n > 0 {
+				log.Debugf("Evicted %d idle sessions", n)
+			}
+		}
+	}
+}
@@ -0,0 +1,165 @@
+package stats
+
+import (
+	"math"
+	"sort"
+)
+
+// exactLimit is the number of values kept by Quantiles before it switches to
+// estimates.
+const exactLimit = 1024
+
+// Quantiles estimates a fixed set of quantiles of a stream of numbers. The
+// first exactLimit numbers are kept, so the quantiles of shorter streams are
+// exact. Past that, every quantile is estimated in constant memory with the
+// P² algorithm of Jain and Chlamtac.
+type Quantiles struct {
+	ps       []float64
+	values   []float64
+	sketches []*p2
+}
+
+// NewQuantiles returns an estimator of the quantiles ps, each in [0, 1].
+func NewQuantiles(ps ...float64) *Quantiles {
+	return &Quantiles{ps: ps}
+}
+
+// Add adds x to the stream.
+func (q *Quantiles) Add(x float64) error {
+	if math.IsNaN(x) || math.IsInf(x, 0) {
+		return ErrNotFinite
+	}
+	if q.sketches != nil {
+		for _, s := range q.sketches {
+			s.add(x)
+		}
+		return nil
+	}
+	q.values = append(q.values, x)
+	if len(q.values) > exactLimit {
+		q.sketches = make([]*p2, len(q.ps))
+		for i, p := range q.ps {
+			q.sketches[i] = newP2(p)
+			for _, v := range q.values {
+				q.sketches[i].add(v)
+			}
+		}
+		q.values = nil
+	}
+	return nil
+}
+
+// Exact reports whether the quantiles are exact rather than estimates.
+func (q *Quantiles) Exact() bool {
+	return q.sketches == nil
+}
+
+// Values returns the quantiles, in the order given to NewQuantiles, or zeros
+// when no number was added.
+func (q *Quantiles) Values() []float64 {
+	res := make([]float64, len(q.ps))
+	if q.sketches != nil {
+		for i, s := range q.sketches {
+			res[i] = s.value()
+		}
+		return res
+	}
+	if len(q.values) == 0 {
+		return res
+	}
+	sorted := append([]float64(nil), q.values...)
+	sort.Float64s(sorted)
+	for i, p := range q.ps {
+		// Linear interpolation between the closest ranks.
+		h := p * float64(len(sorted)-1)
+		lo := int(math.Floor(h))
+		if lo+1 >= len(sorted) {
+			res[i] = sorted[len(sorted)-1]
+			continue
+		}
+		res[i] = sorted[lo] + (h-float64(lo))*(sorted[lo+1]-sorted[lo])
+	}
+	return res
+}
+
+// p2 estimates the quantile p with five markers whose heights follow the
+// minimum, the quantiles p/2, p and (1+p)/2, and the maximum.
+type p2 struct {
+	p       float64
+	count   int
+	heights [5]float64
+	pos     [5]float64
+	desired [5]float64
+	incr    [5]float64
+}
+
+func newP2(p float64) *p2 {
+	return &p2{
+		p:       p,
+		pos:     [5]float64{0, 1, 2, 3, 4},
+		desired: [5]float64{0, 2 * p, 4 * p, 2 + 2*p, 4},
+		incr:    [5]float64{0, p / 2, p, (1 + p) / 2, 1},
+	}
+}
+
+func (s *p2) add(x float64) {
+	if s.count < 5 {
+		s.heights[s.count] = x
+		s.count++
+		if s.count == 5 {
+			sort.Float64s(s.heights[:])
+		}
+		return
+	}
+	s.count++
+
+	var k int
+	switch {
+	case x < s.heights[0]:
+		s.heights[0] = x
+		k = 0
+	case x >= s.heights[4]:
+		s.heights[4] = x
+		k = 3
+	default:
+		for k = 0; k < 3 && x >= s.heights[k+1]; k++ {
+		}
+	}
+	for i := k + 1; i < 5; i++ {
+		s.pos[i]++
+	}
+	for i := range s.desired {
+		s.desired[i] += s.incr[i]
+	}
+
+	for i := 1; i < 4; i++ {
+		d := s.desired[i] - s.pos[i]
+		if d >= 1 && s.pos[i+1]-s.pos[i] > 1 || d <= -1 && s.pos[i-1]-s.pos[i] < -1 {
+			sign := math.Copysign(1, d)
+			h := s.parabolic(i, sign)
+			if s.heights[i-1] >= h || h >= s.heights[i+1] {
+				h = s.linear(i, sign)
+			}
+			s.heights[i] = h
+			s.pos[i] += sign
+		}
+	}
+}
+
+func (s *p2) parabolic(i int, d float64) float64 {
+	n, q := s.pos, s.heights
+	return q[i] + d/(n[i+1]-n[i-1])*
+		((n[i]-n[i-1]+d)*(q[i+1]-q[i])/(n[i+1]-n[i])+
+			(n[i+1]-n[i]-d)*(q[i]-q[i-1])/(n[i]-n[i-1]))
+}
+
+func (s *p2) linear(i int, d float64) float64 {
+	j := i + int(d)
+	return s.heights[i] + d*(s.heights[j]-s.heights[i])/(s.pos[j]-s.pos[i])
+}
+
+// value returns the estimate, which is only used past exactLimit numbers so
+// always after the five markers are initialized.
+func (s *p2) value() float64 {
+	return s.heights[2]
+}
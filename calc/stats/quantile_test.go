@@ -0,0 +1,107 @@
+package stats
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func TestQuantilesExact(t *testing.T) {
+	tests := []struct {
+		values []float64
+		want   []float64
+	}{
+		{nil, []float64{0, 0, 0, 0}},
+		{[]float64{7}, []float64{7, 7, 7, 7}},
+		{[]float64{1, 2}, []float64{1, 1.5, 1.9, 2}},
+		{[]float64{5, 1, 4, 2, 3}, []float64{1, 3, 4.6, 5}},
+		{[]float64{-1, -1, 10, 10}, []float64{-1, 4.5, 10, 10}},
+	}
+	for _, tt := range tests {
+		q := NewQuantiles(0, 0.5, 0.9, 1)
+		for _, v := range tt.values {
+			q.Add(v)
+		}
+		if !q.Exact() {
+			t.Errorf("%v: Exact() = false, want true", tt.values)
+		}
+		got := q.Values()
+		for i := range got {
+			if math.Abs(got[i]-tt.want[i]) > 1e-12 {
+				t.Errorf("%v: Values() = %v, want %v", tt.values, got, tt.want)
+				break
+			}
+		}
+	}
+}
+
+func TestQuantilesSwitch(t *testing.T) {
+	q := NewQuantiles(0.5)
+	for i := 0; i < exactLimit; i++ {
+		q.Add(float64(i))
+	}
+	if !q.Exact() || q.Values()[0] != float64(exactLimit-1)/2 {
+		t.Fatalf("after %d values: Exact() = %v, median %v, want the exact %v", exactLimit, q.Exact(), q.Values()[0], float64(exactLimit-1)/2)
+	}
+	q.Add(exactLimit)
+	if q.Exact() {
+		t.Fatalf("after %d values: Exact() = true, want false", exactLimit+1)
+	}
+	// The sketches are seeded with the kept values, so the estimate right
+	// after the switch is still close.
+	if got := q.Values()[0]; math.Abs(got-exactLimit/2) > 2 {
+		t.Errorf("median of 0..%d estimated as %v", exactLimit, got)
+	}
+	if err := q.Add(math.NaN()); err != ErrNotFinite {
+		t.Errorf("Add(NaN) = %v, want ErrNotFinite", err)
+	}
+}
+
+// exactQuantile returns the p quantile of sorted, interpolated like Values.
+func exactQuantile(sorted []float64, p float64) float64 {
+	h := p * float64(len(sorted)-1)
+	lo := int(h)
+	if lo+1 >= len(sorted) {
+		return sorted[len(sorted)-1]
+	}
+	return sorted[lo] + (h-float64(lo))*(sorted[lo+1]-sorted[lo])
+}
+
+func TestQuantilesEstimates(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	var counter float64
+	tests := []struct {
+		name string
+		n    int
+		next func() float64
+		// tol bounds the error of the estimates, in standard deviations of
+		// the distribution.
+		tol float64
+	}{
+		{"uniform", 100000, rnd.Float64, 0.01},
+		{"normal", 100000, rnd.NormFloat64, 0.02},
+		{"exponential", 100000, rnd.ExpFloat64, 0.03},
+		{"just past the switch", exactLimit + 500, rnd.NormFloat64, 0.1},
+		{"increasing", 100000, func() float64 { counter++; return counter }, 0.01},
+	}
+	ps := []float64{0.1, 0.5, 0.9, 0.99}
+	for _, tt := range tests {
+		q := NewQuantiles(ps...)
+		var s Summary
+		values := make([]float64, tt.n)
+		for i := range values {
+			values[i] = tt.next()
+			q.Add(values[i])
+			s.Add(values[i])
+		}
+		sort.Float64s(values)
+		got := q.Values()
+		for i, p := range ps {
+			want := exactQuantile(values, p)
+			if math.Abs(got[i]-want) > tt.tol*s.StdDev() {
+				t.Errorf("%s: quantile %v estimated as %v, want %v", tt.name, p, got[i], want)
+			}
+		}
+	}
+}
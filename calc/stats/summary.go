@@ -0,0 +1,72 @@
+// Package stats computes descriptive statistics over streams of numbers in
+// constant memory.
+package stats
+
+import (
+	"errors"
+	"math"
+)
+
+// ErrNotFinite is returned when adding NaN or an infinity.
+var ErrNotFinite = errors.New("number is not finite")
+
+// Summary keeps the count, mean, variance, minimum and maximum of a stream of
+// numbers. The mean and variance are updated with Welford's algorithm, which
+// does not lose precision on long streams like a running sum does.
+type Summary struct {
+	count    int64
+	mean, m2 float64
+	min, max float64
+}
+
+// Add adds x to the summary.
+func (s *Summary) Add(x float64) error {
+	if math.IsNaN(x) || math.IsInf(x, 0) {
+		return ErrNotFinite
+	}
+	s.count++
+	if s.count == 1 {
+		s.min, s.max = x, x
+	} else {
+		s.min = math.Min(s.min, x)
+		s.max = math.Max(s.max, x)
+	}
+	delta := x - s.mean
+	s.mean += delta / float64(s.count)
+	s.m2 += delta * (x - s.mean)
+	return nil
+}
+
+// Count returns the number of numbers added.
+func (s *Summary) Count() int64 {
+	return s.count
+}
+
+// Mean returns the mean of the numbers, 0 when there are none.
+func (s *Summary) Mean() float64 {
+	return s.mean
+}
+
+// Variance returns the unbiased sample variance of the numbers, 0 when there
+// are less than two.
+func (s *Summary) Variance() float64 {
+	if s.count < 2 {
+		return 0
+	}
+	return s.m2 / float64(s.count-1)
+}
+
+// StdDev returns the sample standard deviation of the numbers.
+func (s *Summary) StdDev() float64 {
+	return math.Sqrt(s.Variance())
+}
+
+// Min returns the smallest number, 0 when there are none.
+func (s *Summary) Min() float64 {
+	return s.min
+}
+
+// Max returns the largest number, 0 when there are none.
+func (s *Summary) Max() float64 {
+	return s.max
+}
@@ -0,0 +1,54 @@
+package stats
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSummary(t *testing.T) {
+	tests := []struct {
+		name                     string
+		values                   []float64
+		mean, variance, min, max float64
+	}{
+		{"empty", nil, 0, 0, 0, 0},
+		{"single", []float64{-3}, -3, 0, -3, -3},
+		{"pair", []float64{1, 3}, 2, 2, 1, 3},
+		{"constant", []float64{5, 5, 5, 5}, 5, 0, 5, 5},
+		{"spread", []float64{2, 4, 4, 4, 5, 5, 7, 9}, 5, 32.0 / 7, 2, 9},
+		{"negative", []float64{-1, -2, -3}, -2, 1, -3, -1},
+		// A running sum of squares loses the variance to the offset.
+		{"large offset", []float64{1e9 + 4, 1e9 + 7, 1e9 + 13, 1e9 + 16}, 1e9 + 10, 30, 1e9 + 4, 1e9 + 16},
+	}
+	for _, tt := range tests {
+		var s Summary
+		for _, v := range tt.values {
+			if err := s.Add(v); err != nil {
+				t.Fatalf("%s: Add(%v) failed: %v", tt.name, v, err)
+			}
+		}
+		if s.Count() != int64(len(tt.values)) {
+			t.Errorf("%s: Count() = %d, want %d", tt.name, s.Count(), len(tt.values))
+		}
+		if math.Abs(s.Mean()-tt.mean) > 1e-9 || math.Abs(s.Variance()-tt.variance) > 1e-9 || s.Min() != tt.min || s.Max() != tt.max {
+			t.Errorf("%s: mean %v, variance %v, min %v, max %v, want %v, %v, %v, %v",
+				tt.name, s.Mean(), s.Variance(), s.Min(), s.Max(), tt.mean, tt.variance, tt.min, tt.max)
+		}
+		if got, want := s.StdDev(), math.Sqrt(tt.variance); math.Abs(got-want) > 1e-9 {
+			t.Errorf("%s: StdDev() = %v, want %v", tt.name, got, want)
+		}
+	}
+}
+
+func TestSummaryNotFinite(t *testing.T) {
+	for _, x := range []float64{math.NaN(), math.Inf(1), math.Inf(-1)} {
+		var s Summary
+		s.Add(1)
+		if err := s.Add(x); err != ErrNotFinite {
+			t.Errorf("Add(%v) = %v, want ErrNotFinite", x, err)
+		}
+		if s.Count() != 1 || s.Mean() != 1 {
+			t.Errorf("Add(%v) changed the summary to count %d, mean %v", x, s.Count(), s.Mean())
+		}
+	}
+}
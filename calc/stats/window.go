@@ -0,0 +1,158 @@
+package stats
+
+import (
+	"errors"
+	"math"
+	"time"
+)
+
+// MaxWindowLen is the largest number of values a Window holds. Time windows
+// refuse the values past it.
+const MaxWindowLen = 1 << 16
+
+// ErrWindowFull is returned by Add when a time window already holds
+// MaxWindowLen values that have not expired.
+var ErrWindowFull = errors.New("time window is full")
+
+type windowItem struct {
+	seq   int64
+	at    time.Time
+	value float64
+}
+
+// Window aggregates the last values of a stream, either the last size values
+// or the ones added during the last span. The minimum and maximum are kept in
+// monotonic deques and the sum is kept running, so every operation takes
+// amortized constant time. The running sum is compensated (Neumaier), so
+// small values are not lost next to large ones that leave the window first.
+type Window struct {
+	size int
+	span time.Duration
+
+	seq   int64
+	items []windowItem
+	// maxs holds decreasing values, mins increasing ones, so their fronts
+	// are the maximum and minimum of the window.
+	maxs, mins []windowItem
+	// sum+comp is the sum, comp holding the low-order bits lost by sum.
+	sum, comp float64
+}
+
+// NewCountWindow returns a window over the last size values.
+func NewCountWindow(size int) *Window {
+	return &Window{size: size}
+}
+
+// NewTimeWindow returns a window over the values added during the last span.
+func NewTimeWindow(span time.Duration) *Window {
+	return &Window{size: MaxWindowLen, span: span}
+}
+
+// Add adds x to the window at time now, dropping the values that leave the
+// window. A full time window is left untouched and fails with ErrWindowFull,
+// as dropping values before they expire would skew its aggregates.
+func (w *Window) Add(now time.Time, x float64) error {
+	w.Expire(now)
+	if w.span > 0 && len(w.items) >= w.size {
+		return ErrWindowFull
+	}
+	w.seq++
+	it := windowItem{seq: w.seq, at: now, value: x}
+	w.items = append(w.items, it)
+	w.accumulate(x)
+	for len(w.maxs) > 0 && w.maxs[len(w.maxs)-1].value <= x {
+		w.maxs = w.maxs[:len(w.maxs)-1]
+	}
+	w.maxs = append(w.maxs, it)
+	for len(w.mins) > 0 && w.mins[len(w.mins)-1].value >= x {
+		w.mins = w.mins[:len(w.mins)-1]
+	}
+	w.mins = append(w.mins, it)
+
+	for len(w.items) > w.size {
+		w.drop()
+	}
+	return nil
+}
+
+// Expire drops the values of a time window that are older than its span at
+// time now.
+func (w *Window) Expire(now time.Time) {
+	if w.span == 0 {
+		return
+	}
+	for len(w.items) > 0 && !w.items[0].at.After(now.Add(-w.span)) {
+		w.drop()
+	}
+}
+
+// NextExpiry returns when the oldest value of a time window expires.
+func (w *Window) NextExpiry() (time.Time, bool) {
+	if w.span == 0 || len(w.items) == 0 {
+		return time.Time{}, false
+	}
+	return w.items[0].at.Add(w.span), true
+}
+
+// drop removes the oldest value.
+func (w *Window) drop() {
+	it := w.items[0]
+	w.items = w.items[1:]
+	if len(w.items) == 0 {
+		// Start over to clear the accumulated rounding errors.
+		w.sum, w.comp = 0, 0
+	} else {
+		w.accumulate(-it.value)
+	}
+	if w.maxs[0].seq == it.seq {
+		w.maxs = w.maxs[1:]
+	}
+	if w.mins[0].seq == it.seq {
+		w.mins = w.mins[1:]
+	}
+}
+
+// accumulate adds x to the running sum.
+func (w *Window) accumulate(x float64) {
+	t := w.sum + x
+	if math.Abs(w.sum) >= math.Abs(x) {
+		w.comp += (w.sum - t) + x
+	} else {
+		w.comp += (x - t) + w.sum
+	}
+	w.sum = t
+}
+
+// Len returns the number of values in the window.
+func (w *Window) Len() int {
+	return len(w.items)
+}
+
+// Max returns the largest value of the window, 0 when it is empty.
+func (w *Window) Max() float64 {
+	if len(w.maxs) == 0 {
+		return 0
+	}
+	return w.maxs[0].value
+}
+
+// Min returns the smallest value of the window, 0 when it is empty.
+func (w *Window) Min() float64 {
+	if len(w.mins) == 0 {
+		return 0
+	}
+	return w.mins[0].value
+}
+
+// Sum returns the sum of the values of the window.
+func (w *Window) Sum() float64 {
+	return w.sum + w.comp
+}
+
+// Mean returns the mean of the values of the window, 0 when it is empty.
+func (w *Window) Mean() float64 {
+	if len(w.items) == 0 {
+		return 0
+	}
+	return w.Sum() / float64(len(w.items))
+}
@@ -0,0 +1,156 @@
+package stats
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+	"time"
+)
+
+// check compares the aggregates of w with the ones of want.
+func check(t *testing.T, w *Window, want []float64) {
+	t.Helper()
+	wantMax, wantMin, wantSum := 0.0, 0.0, 0.0
+	for i, v := range want {
+		if i == 0 || v > wantMax {
+			wantMax = v
+		}
+		if i == 0 || v < wantMin {
+			wantMin = v
+		}
+		wantSum += v
+	}
+	if w.Len() != len(want) || w.Max() != wantMax || w.Min() != wantMin || math.Abs(w.Sum()-wantSum) > 1e-9 {
+		t.Fatalf("window of %v has len %d, max %v, min %v, sum %v", want, w.Len(), w.Max(), w.Min(), w.Sum())
+	}
+}
+
+func TestCountWindow(t *testing.T) {
+	tests := []struct {
+		size   int
+		values []float64
+	}{
+		{1, []float64{3, 1, 2}},
+		{3, []float64{1, 2, 3, 4, 5}},
+		{3, []float64{5, 4, 3, 2, 1}},
+		{3, []float64{2, 2, 2, 1, 1, 3, 3}},
+		{4, []float64{1, -5, 9, -5, 9, 0, 0, 0, 0, 0}},
+	}
+	now := time.Now()
+	for _, tt := range tests {
+		w := NewCountWindow(tt.size)
+		for i, v := range tt.values {
+			if err := w.Add(now, v); err != nil {
+				t.Fatalf("Add(%v) failed: %v", v, err)
+			}
+			lo := i + 1 - tt.size
+			if lo < 0 {
+				lo = 0
+			}
+			check(t, w, tt.values[lo:i+1])
+		}
+	}
+}
+
+func TestWindowSumMagnitudes(t *testing.T) {
+	tests := []struct {
+		size   int
+		values []float64
+		want   float64
+	}{
+		// A plain running sum loses the 1s to 1e17 and is left at 0 once
+		// it leaves.
+		{2, []float64{1e17, 1, 1}, 2},
+		{3, []float64{1, 1e17, 1, 1, 1}, 3},
+		{2, []float64{-1e17, 0.5, 0.25}, 0.75},
+		{2, []float64{1e100, 1, -1e100, 1, 1}, 2},
+		{2, []float64{1e17, 1e17, 3, 4}, 7},
+	}
+	for _, tt := range tests {
+		w := NewCountWindow(tt.size)
+		for _, v := range tt.values {
+			w.Add(time.Time{}, v)
+		}
+		if w.Sum() != tt.want || w.Mean() != tt.want/float64(w.Len()) {
+			t.Errorf("window of %d over %v has sum %v, mean %v, want %v, %v", tt.size, tt.values, w.Sum(), w.Mean(), tt.want, tt.want/float64(w.Len()))
+		}
+	}
+}
+
+func TestCountWindowRandom(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	w := NewCountWindow(50)
+	var values []float64
+	for i := 0; i < 5000; i++ {
+		v := float64(rnd.Intn(100))
+		w.Add(time.Time{}, v)
+		if values = append(values, v); len(values) > 50 {
+			values = values[1:]
+		}
+		check(t, w, values)
+	}
+}
+
+func TestTimeWindow(t *testing.T) {
+	start := time.Now()
+	at := func(ms int) time.Time { return start.Add(time.Duration(ms) * time.Millisecond) }
+	w := NewTimeWindow(100 * time.Millisecond)
+
+	if _, ok := w.NextExpiry(); ok {
+		t.Errorf("NextExpiry() of an empty window is set")
+	}
+	w.Add(at(0), 5)
+	w.Add(at(10), 1)
+	w.Add(at(50), 3)
+	check(t, w, []float64{5, 1, 3})
+	if got, ok := w.NextExpiry(); !ok || !got.Equal(at(100)) {
+		t.Errorf("NextExpiry() = %v, %v, want %v", got, ok, at(100))
+	}
+
+	// A value expires once its age reaches the span.
+	w.Expire(at(99))
+	check(t, w, []float64{5, 1, 3})
+	w.Expire(at(100))
+	check(t, w, []float64{1, 3})
+	w.Add(at(120), 2)
+	check(t, w, []float64{3, 2})
+	w.Expire(at(1000))
+	check(t, w, nil)
+	if w.Mean() != 0 {
+		t.Errorf("Mean() of an empty window = %v, want 0", w.Mean())
+	}
+}
+
+func TestTimeWindowFull(t *testing.T) {
+	start := time.Now()
+	w := NewTimeWindow(time.Second)
+	for i := 0; i < MaxWindowLen; i++ {
+		if err := w.Add(start, float64(i)); err != nil {
+			t.Fatalf("Add of value %d failed: %v", i, err)
+		}
+	}
+	if err := w.Add(start, -1); err != ErrWindowFull {
+		t.Fatalf("Add to a full window = %v, want ErrWindowFull", err)
+	}
+	// The refused value is not aggregated and nothing was dropped.
+	if w.Len() != MaxWindowLen || w.Min() != 0 || w.Max() != MaxWindowLen-1 {
+		t.Errorf("full window has len %d, min %v, max %v", w.Len(), w.Min(), w.Max())
+	}
+	// Once the values expire there is room again.
+	if err := w.Add(start.Add(time.Second), 7); err != nil {
+		t.Fatalf("Add after the expiry failed: %v", err)
+	}
+	check(t, w, []float64{7})
+}
+
+func TestCountWindowNeverFull(t *testing.T) {
+	w := NewCountWindow(MaxWindowLen)
+	for i := 0; i <= MaxWindowLen; i++ {
+		if err := w.Add(time.Time{}, float64(i)); err != nil {
+			t.Fatalf("Add of value %d failed: %v", i, err)
+		}
+	}
+	if w.Len() != MaxWindowLen || w.Min() != 1 || w.Max() != MaxWindowLen {
+		t.Errorf("window has len %d, min %v, max %v", w.Len(), w.Min(), w.Max())
+	}
+}
@@ -0,0 +1,24 @@
+package auth
+
+import (
+	"context"
+)
+
+// TokenCredentials attaches a bearer token to every RPC of a client, use it
+// with grpc.WithPerRPCCredentials.
+type TokenCredentials struct {
+	Token string
+	// Insecure allows sending the token over a plaintext connection, e.g.
+	// to a proxy terminating TLS.
+	Insecure bool
+}
+
+// GetRequestMetadata implements credentials.PerRPCCredentials.
+func (c *TokenCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + c.Token}, nil
+}
+
+// RequireTransportSecurity implements credentials.PerRPCCredentials.
+func (c *TokenCredentials) RequireTransportSecurity() bool {
+	return !c.Insecure
+}
@@ -0,0 +1,124 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"grpc-course/common/logging"
+
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// ErrorDomain is the domain of the ErrorInfo details attached to the errors.
+const ErrorDomain = "auth.grpc-course"
+
+// Authenticator validates the bearer token of every RPC and enforces the
+// policy.
+type Authenticator struct {
+	Verifier *Verifier
+	Policy   *Policy
+}
+
+func (a *Authenticator) authorize(ctx context.Context, fullMethod string) (context.Context, error) {
+	if a.Policy.IsPublic(fullMethod) {
+		return ctx, nil
+	}
+
+	token, err := bearerToken(ctx)
+	if err != nil {
+		return nil, errorWithInfo(codes.Unauthenticated, err.Error(), "TOKEN_MISSING", nil)
+	}
+	claims, err := a.Verifier.Verify(token)
+	if err != nil {
+		log.Debugf("Rejected token for %s: %v", fullMethod, err)
+		return nil, errorWithInfo(codes.Unauthenticated, "invalid token: "+err.Error(), "TOKEN_INVALID", nil)
+	}
+	logging.AddFields(ctx, log.Fields{"subject": claims.Subject})
+
+	if err := a.Policy.checkScopes(fullMethod, claims); err != nil {
+		return nil, err
+	}
+	return NewContext(ctx, claims), nil
+}
+
+func (p *Policy) checkScopes(fullMethod string, claims *Claims) error {
+	if missing := p.MissingScopes(fullMethod, claims); len(missing) > 0 {
+		log.WithField("subject", claims.Subject).Warnf("Denied call to %s, missing scopes %v", fullMethod, missing)
+		return errorWithInfo(codes.PermissionDenied, "insufficient scopes to call "+fullMethod, "SCOPES_MISSING", map[string]string{
+			"method":  fullMethod,
+			"missing": strings.Join(missing, " "),
+		})
+	}
+	return nil
+}
+
+// Authorize checks that the caller of the RPC of ctx, authenticated by the
+// interceptors, may call fullMethod as part of it, e.g. the method an
+// operation of a batch stands for. It fails with the errors of the
+// interceptors.
+func (p *Policy) Authorize(ctx context.Context, fullMethod string) error {
+	if p.IsPublic(fullMethod) {
+		return nil
+	}
+	claims, ok := ClaimsFromContext(ctx)
+	if !ok {
+		return errorWithInfo(codes.Unauthenticated, "a token is required to call "+fullMethod, "TOKEN_MISSING", nil)
+	}
+	return p.checkScopes(fullMethod, claims)
+}
+
+func bearerToken(ctx context.Context) (string, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return "", errors.New("missing authorization metadata")
+	}
+	const prefix = "bearer "
+	if len(values[0]) <= len(prefix) || !strings.EqualFold(values[0][:len(prefix)], prefix) {
+		return "", errors.New("authorization metadata is not a bearer token")
+	}
+	return values[0][len(prefix):], nil
+}
+
+func errorWithInfo(code codes.Code, msg, reason string, md map[string]string) error {
+	st, err := status.New(code, msg).WithDetails(&errdetails.ErrorInfo{
+		Reason:   reason,
+		Domain:   ErrorDomain,
+		Metadata: md,
+	})
+	if err != nil {
+		return status.Error(code, msg)
+	}
+	return st.Err()
+}
+
+// UnaryServerInterceptor authenticates and authorizes unary RPCs.
+func (a *Authenticator) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := a.authorize(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor authenticates and authorizes streaming RPCs.
+func (a *Authenticator) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := a.authorize(stream.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+		wrapped := grpc_middleware.WrapServerStream(stream)
+		wrapped.WrappedContext = ctx
+		return handler(srv, wrapped)
+	}
+}
@@ -0,0 +1,57 @@
+package auth
+
+import (
+	"strings"
+)
+
+// Policy declares which scopes a token needs to call each method, e.g.
+//
+//	public:
+//	  - /grpc.reflection.v1alpha.ServerReflection/ServerReflectionInfo
+//	rules:
+//	  /calc.Calculator/PrimeDecompose: [calc.factor]
+//	  /calc.Calculator/*: [calc.use]
+//
+// A method is matched by its full name first, then by its service followed by
+// "/*", then by "*". Methods matching no rule only need a valid token.
+type Policy struct {
+	// Public lists the methods callable without a token.
+	Public []string `yaml:"public"`
+	// Rules maps methods to the scopes required to call them.
+	Rules map[string][]string `yaml:"rules"`
+}
+
+// IsPublic reports whether the method can be called without a token.
+func (p *Policy) IsPublic(fullMethod string) bool {
+	for _, m := range p.Public {
+		if m == fullMethod {
+			return true
+		}
+	}
+	return false
+}
+
+// RequiredScopes returns the scopes needed to call the method.
+func (p *Policy) RequiredScopes(fullMethod string) []string {
+	if scopes, ok := p.Rules[fullMethod]; ok {
+		return scopes
+	}
+	if i := strings.LastIndex(fullMethod, "/"); i > 0 {
+		if scopes, ok := p.Rules[fullMethod[:i]+"/*"]; ok {
+			return scopes
+		}
+	}
+	return p.Rules["*"]
+}
+
+// MissingScopes returns the scopes required for the method that were not
+// granted in the claims.
+func (p *Policy) MissingScopes(fullMethod string, claims *Claims) []string {
+	var missing []string
+	for _, s := range p.RequiredScopes(fullMethod) {
+		if !claims.HasScope(s) {
+			missing = append(missing, s)
+		}
+	}
+	return missing
+}
@@ -0,0 +1,121 @@
+package auth
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+var testPolicy = &Policy{
+	Public: []string{"/grpc.health.v1.Health/Check"},
+	Rules: map[string][]string{
+		"/calc.Calculator/PrimeDecompose": {"calc.factor"},
+		"/calc.Calculator/*":              {"calc.use"},
+		"/admin.Admin/*":                  {"admin.read", "admin.write"},
+		"*":                               {"any"},
+	},
+}
+
+func TestRequiredScopes(t *testing.T) {
+	tests := []struct {
+		method string
+		want   []string
+	}{
+		// The full name wins over the service, which wins over "*".
+		{"/calc.Calculator/PrimeDecompose", []string{"calc.factor"}},
+		{"/calc.Calculator/Sum", []string{"calc.use"}},
+		{"/admin.Admin/Reset", []string{"admin.read", "admin.write"}},
+		{"/greet.GreetService/Greet", []string{"any"}},
+		// The service must match exactly, not as a prefix.
+		{"/calc.CalculatorV2/Sum", []string{"any"}},
+	}
+	for _, tt := range tests {
+		if got := testPolicy.RequiredScopes(tt.method); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("RequiredScopes(%q) = %v, want %v", tt.method, got, tt.want)
+		}
+	}
+
+	// Without a "*" rule, the other methods only need a valid token.
+	p := &Policy{Rules: map[string][]string{"/calc.Calculator/*": {"calc.use"}}}
+	if got := p.RequiredScopes("/greet.GreetService/Greet"); len(got) != 0 {
+		t.Errorf("RequiredScopes of an unmatched method = %v, want none", got)
+	}
+}
+
+func TestMissingScopes(t *testing.T) {
+	tests := []struct {
+		method string
+		scope  string
+		want   []string
+	}{
+		{"/calc.Calculator/Sum", "calc.use", nil},
+		{"/calc.Calculator/Sum", "calc.user calc", []string{"calc.use"}},
+		// The method rule replaces the service one, it does not add to it.
+		{"/calc.Calculator/PrimeDecompose", "calc.use", []string{"calc.factor"}},
+		{"/calc.Calculator/PrimeDecompose", "calc.factor", nil},
+		{"/admin.Admin/Reset", "admin.read", []string{"admin.write"}},
+		{"/admin.Admin/Reset", "admin.write  admin.read", nil},
+		{"/admin.Admin/Reset", "", []string{"admin.read", "admin.write"}},
+	}
+	for _, tt := range tests {
+		got := testPolicy.MissingScopes(tt.method, &Claims{Scope: tt.scope})
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("MissingScopes(%q, %q) = %v, want %v", tt.method, tt.scope, got, tt.want)
+		}
+	}
+}
+
+func TestAuthorize(t *testing.T) {
+	keys := KeySet{"k1": []byte("secret-one")}
+	a := &Authenticator{Verifier: &Verifier{Keys: keys}, Policy: testPolicy}
+	token := func(scope string) string {
+		t.Helper()
+		s, err := Sign(keys, "k1", Claims{Scope: scope}, time.Minute)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return "Bearer " + s
+	}
+	tests := []struct {
+		name          string
+		method        string
+		authorization string
+		wantCode      codes.Code
+		wantReason    string
+	}{
+		{"public", "/grpc.health.v1.Health/Check", "", codes.OK, ""},
+		{"granted", "/calc.Calculator/Sum", token("calc.use"), codes.OK, ""},
+		{"lower case scheme", "/calc.Calculator/Sum", "bearer" + token("calc.use")[6:], codes.OK, ""},
+		{"no token", "/calc.Calculator/Sum", "", codes.Unauthenticated, "TOKEN_MISSING"},
+		{"not bearer", "/calc.Calculator/Sum", "Basic Ym9iOnB3", codes.Unauthenticated, "TOKEN_MISSING"},
+		{"invalid token", "/calc.Calculator/Sum", "Bearer not.a.token", codes.Unauthenticated, "TOKEN_INVALID"},
+		{"missing scope", "/calc.Calculator/PrimeDecompose", token("calc.use"), codes.PermissionDenied, "SCOPES_MISSING"},
+	}
+	for _, tt := range tests {
+		ctx := context.Background()
+		if tt.authorization != "" {
+			ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", tt.authorization))
+		}
+		_, err := a.authorize(ctx, tt.method)
+		st := status.Convert(err)
+		if st.Code() != tt.wantCode {
+			t.Errorf("%s: authorize(%q) = %v, want code %v", tt.name, tt.method, err, tt.wantCode)
+			continue
+		}
+		reason := ""
+		for _, d := range st.Details() {
+			if info, ok := d.(*errdetails.ErrorInfo); ok {
+				reason = info.Reason
+			}
+		}
+		if reason != tt.wantReason {
+			t.Errorf("%s: authorize(%q) has reason %q, want %q", tt.name, tt.method, reason, tt.wantReason)
+		}
+	}
+}
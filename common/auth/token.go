@@ -0,0 +1,141 @@
+// Package auth authenticates callers with HMAC signed JWT bearer tokens and
+// authorizes them against a declarative per-method scope policy.
+package auth
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"time"
+
+	jwt "github.com/dgrijalva/jwt-go"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// Claims are the JWT claims the servers understand. Scope holds the granted
+// scopes separated by spaces, as in OAuth 2.0.
+type Claims struct {
+	Scope string `json:"scope,omitempty"`
+	jwt.StandardClaims
+}
+
+// Scopes returns the granted scopes.
+func (c *Claims) Scopes() []string {
+	return strings.Fields(c.Scope)
+}
+
+// HasScope reports whether the scope was granted.
+func (c *Claims) HasScope(scope string) bool {
+	for _, s := range c.Scopes() {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// KeySet holds the HMAC secrets tokens may be signed with, by key ID. The key
+// ID of a token is taken from its "kid" header.
+type KeySet map[string][]byte
+
+// LoadKeySet reads a YAML file mapping key IDs to base64 encoded secrets:
+//
+//	keys:
+//	  2020-05: c2VjcmV0LXNpZ25pbmcta2V5...
+func LoadKeySet(path string) (KeySet, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading key set: %v", err)
+	}
+	var file struct {
+		Keys map[string]string `yaml:"keys"`
+	}
+	if err := yaml.UnmarshalStrict(data, &file); err != nil {
+		return nil, fmt.Errorf("parsing key set %s: %v", path, err)
+	}
+	if len(file.Keys) == 0 {
+		return nil, fmt.Errorf("no keys found in %s", path)
+	}
+	keys := make(KeySet, len(file.Keys))
+	for id, secret := range file.Keys {
+		b, err := base64.StdEncoding.DecodeString(secret)
+		if err != nil {
+			return nil, fmt.Errorf("decoding key %q: %v", id, err)
+		}
+		keys[id] = b
+	}
+	return keys, nil
+}
+
+// Verifier checks the signature and the standard claims of tokens.
+type Verifier struct {
+	Keys KeySet
+	// Issuer and Audience, when not empty, must match the iss and aud
+	// claims.
+	Issuer   string
+	Audience string
+}
+
+// Verify parses the token and returns its claims when it is valid. Tokens
+// must expire.
+func (v *Verifier) Verify(token string) (*Claims, error) {
+	claims := &Claims{}
+	_, err := jwt.ParseWithClaims(token, claims, func(t *jwt.Token) (interface{}, error) {
+		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method %v", t.Header["alg"])
+		}
+		kid, _ := t.Header["kid"].(string)
+		key, ok := v.Keys[kid]
+		if !ok {
+			return nil, fmt.Errorf("unknown key %q", kid)
+		}
+		return key, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	// The standard claims are valid without exp, which would make the token
+	// valid forever.
+	if claims.ExpiresAt == 0 {
+		return nil, errors.New("token has no expiration time")
+	}
+	if v.Issuer != "" && !claims.VerifyIssuer(v.Issuer, true) {
+		return nil, fmt.Errorf("token issued by %q, expected %q", claims.Issuer, v.Issuer)
+	}
+	if v.Audience != "" && !claims.VerifyAudience(v.Audience, true) {
+		return nil, fmt.Errorf("token for audience %q, expected %q", claims.Audience, v.Audience)
+	}
+	return claims, nil
+}
+
+// Sign issues a token with the claims, signed with the key kid of the key set
+// and valid for ttl from now.
+func Sign(keys KeySet, kid string, claims Claims, ttl time.Duration) (string, error) {
+	key, ok := keys[kid]
+	if !ok {
+		return "", fmt.Errorf("unknown key %q", kid)
+	}
+	now := time.Now()
+	claims.IssuedAt = now.Unix()
+	claims.ExpiresAt = now.Add(ttl).Unix()
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	token.Header["kid"] = kid
+	return token.SignedString(key)
+}
+
+type claimsKey struct{}
+
+// NewContext returns a copy of ctx carrying the verified claims.
+func NewContext(ctx context.Context, claims *Claims) context.Context {
+	return context.WithValue(ctx, claimsKey{}, claims)
+}
+
+// ClaimsFromContext returns the claims of the token the RPC was authenticated
+// with.
+func ClaimsFromContext(ctx context.Context) (*Claims, bool) {
+	claims, ok := ctx.Value(claimsKey{}).(*Claims)
+	return claims, ok
+}
@@ -0,0 +1,86 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"testing"
+	"time"
+
+	jwt "github.com/dgrijalva/jwt-go"
+)
+
+func TestVerify(t *testing.T) {
+	keys := KeySet{"k1": []byte("secret-one"), "k2": []byte("secret-two")}
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	v := &Verifier{Keys: keys, Issuer: "issuer", Audience: "calc"}
+	now := time.Now()
+	valid := jwt.StandardClaims{
+		Subject:   "bob",
+		Issuer:    "issuer",
+		Audience:  "calc",
+		ExpiresAt: now.Add(time.Hour).Unix(),
+	}
+	// sign returns a token of the claims signed by method with key, carrying
+	// kid when not empty.
+	sign := func(method jwt.SigningMethod, key interface{}, kid string, edit func(c *jwt.StandardClaims)) string {
+		t.Helper()
+		claims := Claims{Scope: "calc.use", StandardClaims: valid}
+		if edit != nil {
+			edit(&claims.StandardClaims)
+		}
+		token := jwt.NewWithClaims(method, claims)
+		if kid != "" {
+			token.Header["kid"] = kid
+		}
+		s, err := token.SignedString(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return s
+	}
+	signed, err := Sign(keys, "k2", Claims{StandardClaims: jwt.StandardClaims{Subject: "bob", Issuer: "issuer", Audience: "calc"}}, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name  string
+		token string
+		ok    bool
+	}{
+		{"valid", sign(jwt.SigningMethodHS256, keys["k1"], "k1", nil), true},
+		{"other key", sign(jwt.SigningMethodHS512, keys["k2"], "k2", nil), true},
+		{"issued by Sign", signed, true},
+		{"alg none", sign(jwt.SigningMethodNone, jwt.UnsafeAllowNoneSignatureType, "k1", nil), false},
+		{"alg RS256", sign(jwt.SigningMethodRS256, rsaKey, "k1", nil), false},
+		{"wrong key", sign(jwt.SigningMethodHS256, keys["k2"], "k1", nil), false},
+		{"unknown kid", sign(jwt.SigningMethodHS256, keys["k1"], "k3", nil), false},
+		{"missing kid", sign(jwt.SigningMethodHS256, keys["k1"], "", nil), false},
+		{"wrong issuer", sign(jwt.SigningMethodHS256, keys["k1"], "k1", func(c *jwt.StandardClaims) { c.Issuer = "other" }), false},
+		{"missing issuer", sign(jwt.SigningMethodHS256, keys["k1"], "k1", func(c *jwt.StandardClaims) { c.Issuer = "" }), false},
+		{"wrong audience", sign(jwt.SigningMethodHS256, keys["k1"], "k1", func(c *jwt.StandardClaims) { c.Audience = "greet" }), false},
+		{"missing audience", sign(jwt.SigningMethodHS256, keys["k1"], "k1", func(c *jwt.StandardClaims) { c.Audience = "" }), false},
+		{"missing exp", sign(jwt.SigningMethodHS256, keys["k1"], "k1", func(c *jwt.StandardClaims) { c.ExpiresAt = 0 }), false},
+		{"expired", sign(jwt.SigningMethodHS256, keys["k1"], "k1", func(c *jwt.StandardClaims) { c.ExpiresAt = now.Add(-time.Minute).Unix() }), false},
+		{"not yet valid", sign(jwt.SigningMethodHS256, keys["k1"], "k1", func(c *jwt.StandardClaims) { c.NotBefore = now.Add(time.Hour).Unix() }), false},
+		{"garbage", "not.a.token", false},
+	}
+	for _, tt := range tests {
+		claims, err := v.Verify(tt.token)
+		if (err == nil) != tt.ok {
+			t.Errorf("%s: Verify() = %v, want ok %v", tt.name, err, tt.ok)
+			continue
+		}
+		if tt.ok && claims.Subject != "bob" {
+			t.Errorf("%s: Verify() returned subject %q, want bob", tt.name, claims.Subject)
+		}
+	}
+
+	// Issuer and audience are only checked when set.
+	lax := &Verifier{Keys: keys}
+	if _, err := lax.Verify(sign(jwt.SigningMethodHS256, keys["k1"], "k1", func(c *jwt.StandardClaims) { c.Issuer, c.Audience = "", "" })); err != nil {
+		t.Errorf("Verify() without issuer and audience = %v, want nil", err)
+	}
+}
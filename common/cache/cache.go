@@ -0,0 +1,181 @@
+// Package cache keeps the responses of deterministic RPCs in a bounded LRU
+// cache, so repeated requests are answered without running the handler again.
+package cache
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// Outcome says how Do got its value.
+type Outcome string
+
+const (
+	// Hit means the value was in the cache.
+	Hit Outcome = "hit"
+	// Miss means the value was computed by the caller.
+	Miss Outcome = "miss"
+	// Shared means the value was computed by a concurrent caller of the
+	// same key.
+	Shared Outcome = "shared"
+)
+
+// errPanicked is the error of the computations that panicked.
+var errPanicked = errors.New("cache: computation panicked")
+
+// Cache is an LRU cache of a bounded number of entries, each kept for a
+// bounded time. It is safe for concurrent use.
+type Cache struct {
+	size int
+	ttl  time.Duration
+
+	mu    sync.Mutex
+	order *list.List // of *entry, most recently used first
+	items map[string]*list.Element
+	calls map[string]*call
+}
+
+type entry struct {
+	key     string
+	value   interface{}
+	expires time.Time
+}
+
+// call is a computation in flight that the concurrent callers of its key
+// wait for.
+type call struct {
+	done  chan struct{}
+	value interface{}
+	err   error
+}
+
+// New returns a cache of size > 0 entries expiring after ttl. A zero ttl
+// keeps the entries until they are evicted.
+func New(size int, ttl time.Duration) *Cache {
+	return &Cache{
+		size:  size,
+		ttl:   ttl,
+		order: list.New(),
+		items: make(map[string]*list.Element),
+		calls: make(map[string]*call),
+	}
+}
+
+// Get returns the value of key, if cached and not expired.
+func (c *Cache) Get(key string) (interface{}, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.get(key)
+}
+
+func (c *Cache) get(key string) (interface{}, bool) {
+	el, ok := c.items[key]
+	if !ok {
+		return nil, false
+	}
+	e := el.Value.(*entry)
+	if c.ttl > 0 && !time.Now().Before(e.expires) {
+		c.remove(el)
+		return nil, false
+	}
+	c.order.MoveToFront(el)
+	return e.value, true
+}
+
+// Add caches value under key, evicting the least recently used entry when
+// the cache is full.
+func (c *Cache) Add(key string, value interface{}) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.add(key, value)
+}
+
+func (c *Cache) add(key string, value interface{}) {
+	expires := time.Now().Add(c.ttl)
+	if el, ok := c.items[key]; ok {
+		e := el.Value.(*entry)
+		e.value, e.expires = value, expires
+		c.order.MoveToFront(el)
+		return
+	}
+	c.items[key] = c.order.PushFront(&entry{key: key, value: value, expires: expires})
+	for c.order.Len() > c.size {
+		c.remove(c.order.Back())
+		evictions.Inc()
+	}
+}
+
+// Remove deletes the entry of key, if any.
+func (c *Cache) Remove(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if el, ok := c.items[key]; ok {
+		c.remove(el)
+	}
+}
+
+func (c *Cache) remove(el *list.Element) {
+	c.order.Remove(el)
+	delete(c.items, el.Value.(*entry).key)
+}
+
+// Len returns the number of cached entries, including the expired ones not
+// evicted yet.
+func (c *Cache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
+
+// Do returns the value of key, calling fn to compute and cache it on a miss.
+// Concurrent callers of the same key wait for the first one's fn instead of
+// calling their own. Errors are not cached nor shared: when fn fails, one of
+// the waiting callers calls its own fn. Waiting gives up with ctx.Err() once
+// ctx is done.
+func (c *Cache) Do(ctx context.Context, key string, fn func() (interface{}, error)) (interface{}, Outcome, error) {
+	for {
+		c.mu.Lock()
+		if v, ok := c.get(key); ok {
+			c.mu.Unlock()
+			return v, Hit, nil
+		}
+		cl, ok := c.calls[key]
+		if !ok {
+			cl = &call{done: make(chan struct{})}
+			c.calls[key] = cl
+			c.mu.Unlock()
+			return c.run(key, cl, fn)
+		}
+		c.mu.Unlock()
+
+		select {
+		case <-cl.done:
+		case <-ctx.Done():
+			return nil, Shared, ctx.Err()
+		}
+		if cl.err == nil {
+			return cl.value, Shared, nil
+		}
+	}
+}
+
+// run calls fn for the waiters of cl. The call is finished even if fn panics
+// so that the waiters are not stuck.
+func (c *Cache) run(key string, cl *call, fn func() (interface{}, error)) (v interface{}, o Outcome, err error) {
+	defer func() {
+		c.mu.Lock()
+		delete(c.calls, key)
+		if cl.err == nil {
+			c.add(key, cl.value)
+		}
+		c.mu.Unlock()
+		close(cl.done)
+	}()
+	// The error stays set if fn panics.
+	cl.err = errPanicked
+	cl.value, cl.err = fn()
+	return cl.value, Miss, cl.err
+}
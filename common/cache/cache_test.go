@@ -0,0 +1,243 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestLRU(t *testing.T) {
+	tests := []struct {
+		name string
+		ops  func(c *Cache)
+		want map[string]interface{}
+	}{
+		{"evicts the oldest", func(c *Cache) {
+			c.Add("a", 1)
+			c.Add("b", 2)
+			c.Add("c", 3)
+		}, map[string]interface{}{"b": 2, "c": 3}},
+		{"get refreshes", func(c *Cache) {
+			c.Add("a", 1)
+			c.Add("b", 2)
+			c.Get("a")
+			c.Add("c", 3)
+		}, map[string]interface{}{"a": 1, "c": 3}},
+		{"add replaces and refreshes", func(c *Cache) {
+			c.Add("a", 1)
+			c.Add("b", 2)
+			c.Add("a", 4)
+			c.Add("c", 3)
+		}, map[string]interface{}{"a": 4, "c": 3}},
+		{"remove", func(c *Cache) {
+			c.Add("a", 1)
+			c.Add("b", 2)
+			c.Remove("a")
+			c.Remove("z")
+			c.Add("c", 3)
+		}, map[string]interface{}{"b": 2, "c": 3}},
+		{"missing get does not refresh", func(c *Cache) {
+			c.Add("a", 1)
+			c.Add("b", 2)
+			c.Get("z")
+			c.Add("c", 3)
+		}, map[string]interface{}{"b": 2, "c": 3}},
+	}
+	for _, tt := range tests {
+		c := New(2, 0)
+		tt.ops(c)
+		if c.Len() != len(tt.want) {
+			t.Errorf("%s: Len() = %d, want %d", tt.name, c.Len(), len(tt.want))
+		}
+		for _, k := range []string{"a", "b", "c"} {
+			v, ok := c.Get(k)
+			if want, cached := tt.want[k]; ok != cached || v != want {
+				t.Errorf("%s: Get(%q) = %v, %v, want %v, %v", tt.name, k, v, ok, want, cached)
+			}
+		}
+	}
+}
+
+func TestTTL(t *testing.T) {
+	c := New(10, 20*time.Millisecond)
+	c.Add("a", 1)
+	if _, ok := c.Get("a"); !ok {
+		t.Fatal("Get of a fresh entry missed")
+	}
+	time.Sleep(30 * time.Millisecond)
+	if _, ok := c.Get("a"); ok {
+		t.Error("Get of an expired entry hit")
+	}
+	if c.Len() != 0 {
+		t.Errorf("Len() = %d after the expired entry was looked up, want 0", c.Len())
+	}
+}
+
+func TestDo(t *testing.T) {
+	c := New(10, 0)
+	errFailed := errors.New("failed")
+	tests := []struct {
+		key     string
+		value   interface{}
+		err     error
+		want    interface{}
+		outcome Outcome
+	}{
+		{"a", 1, nil, 1, Miss},
+		{"a", 2, nil, 1, Hit},
+		{"b", nil, errFailed, nil, Miss},
+		// Errors are not cached.
+		{"b", 3, nil, 3, Miss},
+		{"b", 4, nil, 3, Hit},
+	}
+	for _, tt := range tests {
+		v, outcome, err := c.Do(context.Background(), tt.key, func() (interface{}, error) {
+			return tt.value, tt.err
+		})
+		if v != tt.want || outcome != tt.outcome || err != tt.err {
+			t.Errorf("Do(%q) = %v, %v, %v, want %v, %v, %v", tt.key, v, outcome, err, tt.want, tt.outcome, tt.err)
+		}
+	}
+}
+
+// waiters calls Do on key from n goroutines while the leader, already
+// running, is blocked. It returns once the leader is released and every
+// waiter is done, with the outcomes of the waiters and the number of calls
+// of their fn.
+func waiters(t *testing.T, c *Cache, key string, n int, release func()) ([]Outcome, int32) {
+	t.Helper()
+	var calls int32
+	outcomes := make([]Outcome, n)
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			v, outcome, err := c.Do(context.Background(), key, func() (interface{}, error) {
+				atomic.AddInt32(&calls, 1)
+				return "waiter", nil
+			})
+			if err != nil {
+				t.Errorf("waiter %d failed: %v", i, err)
+			}
+			if outcome != Miss && v != "leader" && v != "waiter" {
+				t.Errorf("waiter %d got %v", i, v)
+			}
+			outcomes[i] = outcome
+		}(i)
+	}
+	// Give the waiters time to queue up behind the leader. Late ones hit the
+	// cache instead, which the tests allow.
+	time.Sleep(20 * time.Millisecond)
+	release()
+	wg.Wait()
+	return outcomes, calls
+}
+
+func TestDoSingleFlight(t *testing.T) {
+	c := New(10, 0)
+	release := make(chan struct{})
+	started := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		v, outcome, err := c.Do(context.Background(), "k", func() (interface{}, error) {
+			close(started)
+			<-release
+			return "leader", nil
+		})
+		if v != "leader" || outcome != Miss || err != nil {
+			t.Errorf("leader got %v, %v, %v", v, outcome, err)
+		}
+	}()
+	<-started
+	outcomes, calls := waiters(t, c, "k", 10, func() { close(release) })
+	<-done
+	if calls != 0 {
+		t.Errorf("the waiters called fn %d times, want 0", calls)
+	}
+	for i, o := range outcomes {
+		if o != Shared && o != Hit {
+			t.Errorf("waiter %d got outcome %v, want shared or hit", i, o)
+		}
+	}
+}
+
+func TestDoLeaderFails(t *testing.T) {
+	errFailed := errors.New("failed")
+	tests := []struct {
+		name string
+		fn   func() (interface{}, error)
+	}{
+		{"error", func() (interface{}, error) { return nil, errFailed }},
+		{"panic", func() (interface{}, error) { panic("boom") }},
+	}
+	for _, tt := range tests {
+		c := New(10, 0)
+		release := make(chan struct{})
+		started := make(chan struct{})
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			defer func() {
+				// The panic reaches the leader.
+				if r := recover(); (r != nil) != (tt.name == "panic") {
+					t.Errorf("%s: leader recovered %v", tt.name, r)
+				}
+			}()
+			_, outcome, err := c.Do(context.Background(), "k", func() (interface{}, error) {
+				close(started)
+				<-release
+				return tt.fn()
+			})
+			if outcome != Miss || err != errFailed {
+				t.Errorf("%s: leader got %v, %v", tt.name, outcome, err)
+			}
+		}()
+		<-started
+		outcomes, calls := waiters(t, c, "k", 10, func() { close(release) })
+		<-done
+		// One waiter takes over, the others share its value.
+		if calls != 1 {
+			t.Errorf("%s: the waiters called fn %d times, want 1", tt.name, calls)
+		}
+		misses := 0
+		for _, o := range outcomes {
+			if o == Miss {
+				misses++
+			}
+		}
+		if misses != 1 {
+			t.Errorf("%s: %d waiters missed, want 1", tt.name, misses)
+		}
+		if v, ok := c.Get("k"); !ok || v != "waiter" {
+			t.Errorf("%s: cached %v, %v, want the value of the waiter", tt.name, v, ok)
+		}
+	}
+}
+
+func TestDoWaiterContext(t *testing.T) {
+	c := New(10, 0)
+	release := make(chan struct{})
+	started := make(chan struct{})
+	go c.Do(context.Background(), "k", func() (interface{}, error) {
+		close(started)
+		<-release
+		return 1, nil
+	})
+	<-started
+	defer close(release)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, outcome, err := c.Do(ctx, "k", func() (interface{}, error) {
+		t.Error("the waiter called fn")
+		return nil, nil
+	})
+	if outcome != Shared || err != context.DeadlineExceeded {
+		t.Errorf("Do with an expiring context = %v, %v, want shared, %v", outcome, err, context.DeadlineExceeded)
+	}
+}
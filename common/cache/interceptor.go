@@ -0,0 +1,193 @@
+package cache
+
+import (
+	"context"
+	"crypto/sha256"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	protov2 "google.golang.org/protobuf/proto"
+)
+
+var (
+	lookups = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "grpc_server_cache_lookups_total",
+		Help: "Number of RPCs looked up in the response cache, by method and outcome (hit, miss or shared).",
+	}, []string{"grpc_method", "outcome"})
+	evictions = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "grpc_server_cache_evictions_total",
+		Help: "Number of responses evicted from the response cache to make room.",
+	})
+)
+
+// Interceptor answers the RPCs of deterministic methods, whose responses
+// only depend on their request, from a Cache. Only successful RPCs are
+// cached.
+type Interceptor struct {
+	Cache *Cache
+	// Unary lists the full names of the cached unary methods.
+	Unary []string
+	// Streams maps the full names of the cached server-streaming methods to
+	// a constructor of their request. Their responses are recorded as sent
+	// and replayed in order.
+	Streams map[string]func() proto.Message
+	// Bypass, when set, reports the requests of the cached methods that must
+	// not be cached, e.g. those whose response depends on more than them.
+	Bypass func(req interface{}) bool
+	// MaxBytes, when positive, is the size of the largest request and
+	// response cached, the responses of a stream counting together. The
+	// cache is bounded by entries, so this bounds its memory.
+	MaxBytes int
+}
+
+// bypass reports whether req must not be cached.
+func (i *Interceptor) bypass(req interface{}) bool {
+	return i.Bypass != nil && i.Bypass(req)
+}
+
+// tooLarge reports whether messages of size bytes must not be cached.
+func (i *Interceptor) tooLarge(size int) bool {
+	return i.MaxBytes > 0 && size > i.MaxBytes
+}
+
+// key returns the cache key of req, the SHA-256 digest of its method and its
+// deterministic wire encoding, unless req must not be cached.
+func (i *Interceptor) key(method string, req interface{}) (string, bool) {
+	m, ok := req.(proto.Message)
+	if !ok || i.tooLarge(proto.Size(m)) {
+		return "", false
+	}
+	b, err := protov2.MarshalOptions{Deterministic: true}.Marshal(proto.MessageV2(m))
+	if err != nil {
+		return "", false
+	}
+	h := sha256.New()
+	h.Write([]byte(method + "\x00"))
+	h.Write(b)
+	return string(h.Sum(nil)), true
+}
+
+// UnaryServerInterceptor caches the responses of the Unary methods.
+func (i *Interceptor) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	methods := make(map[string]bool, len(i.Unary))
+	for _, m := range i.Unary {
+		methods[m] = true
+	}
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !methods[info.FullMethod] || i.bypass(req) {
+			return handler(ctx, req)
+		}
+		k, ok := i.key(info.FullMethod, req)
+		if !ok {
+			return handler(ctx, req)
+		}
+		resp, outcome, err := i.Cache.Do(ctx, k, func() (interface{}, error) {
+			return handler(ctx, req)
+		})
+		lookups.WithLabelValues(info.FullMethod, string(outcome)).Inc()
+		if err != nil {
+			if outcome != Miss {
+				// Only a done context fails a lookup.
+				return nil, status.FromContextError(err).Err()
+			}
+			return nil, err
+		}
+		if outcome != Miss {
+			// The cached response is shared, later interceptors get a copy
+			// of their own.
+			return proto.Clone(resp.(proto.Message)), nil
+		}
+		if m, ok := resp.(proto.Message); ok && i.tooLarge(proto.Size(m)) {
+			i.Cache.Remove(k)
+		}
+		return resp, nil
+	}
+}
+
+// StreamServerInterceptor caches the responses of the Streams methods.
+func (i *Interceptor) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		newReq, ok := i.Streams[info.FullMethod]
+		if !ok || !info.IsServerStream || info.IsClientStream {
+			return handler(srv, stream)
+		}
+		req := newReq()
+		if err := stream.RecvMsg(req); err != nil {
+			return err
+		}
+		rec := &recordingStream{ServerStream: stream, req: req}
+		if i.bypass(req) {
+			return handler(srv, rec)
+		}
+		k, ok := i.key(info.FullMethod, req)
+		if !ok {
+			return handler(srv, rec)
+		}
+		v, outcome, err := i.Cache.Do(stream.Context(), k, func() (interface{}, error) {
+			if err := handler(srv, rec); err != nil {
+				return nil, err
+			}
+			return rec.sent, nil
+		})
+		lookups.WithLabelValues(info.FullMethod, string(outcome)).Inc()
+		if outcome == Miss {
+			// The responses went out as they were recorded.
+			if err == nil && i.tooLarge(rec.size) {
+				i.Cache.Remove(k)
+			}
+			return err
+		}
+		if err != nil {
+			return status.FromContextError(err).Err()
+		}
+		for _, m := range v.([]proto.Message) {
+			if err := stream.SendMsg(m); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// recordingStream hands the request already received by the interceptor to
+// the handler and records the responses it sends.
+type recordingStream struct {
+	grpc.ServerStream
+	req      proto.Message
+	received bool
+	sent     []proto.Message
+	// size is the total size of sent.
+	size int
+}
+
+func (s *recordingStream) RecvMsg(m interface{}) error {
+	if s.received {
+		return s.ServerStream.RecvMsg(m)
+	}
+	s.received = true
+	proto.Merge(m.(proto.Message), s.req)
+	return nil
+}
+
+func (s *recordingStream) SendMsg(m interface{}) error {
+	if err := s.ServerStream.SendMsg(m); err != nil {
+		return err
+	}
+	if pm, ok := m.(proto.Message); ok {
+		s.sent = append(s.sent, proto.Clone(pm))
+		s.size += proto.Size(pm)
+	}
+	return nil
+}
+
+// ServerOptions returns the cache interceptors. They should come last so
+// that the RPCs answered from the cache still go through authorization.
+func (i *Interceptor) ServerOptions() []grpc.ServerOption {
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(i.UnaryServerInterceptor()),
+		grpc.ChainStreamInterceptor(i.StreamServerInterceptor()),
+	}
+}
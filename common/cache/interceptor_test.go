@@ -0,0 +1,195 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	errFailed := errors.New("failed")
+	i := &Interceptor{
+		Cache:  New(10, 0),
+		Unary:  []string{"/test/Cached"},
+		Bypass: func(req interface{}) bool { return req.(*wrapperspb.Int64Value).Value < 0 },
+	}
+	interceptor := i.UnaryServerInterceptor()
+	tests := []struct {
+		method    string
+		req       int64
+		fail      bool
+		wantCalls int
+	}{
+		{"/test/Cached", 1, false, 1},
+		{"/test/Cached", 1, false, 0},
+		{"/test/Cached", 2, false, 1},
+		{"/test/Other", 1, false, 1},
+		{"/test/Other", 1, false, 1},
+		{"/test/Cached", -1, false, 1},
+		{"/test/Cached", -1, false, 1},
+		{"/test/Cached", 3, true, 1},
+		{"/test/Cached", 3, true, 1},
+	}
+	var shared proto.Message
+	for _, tt := range tests {
+		calls := 0
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+			calls++
+			if tt.fail {
+				return nil, errFailed
+			}
+			return &wrapperspb.Int64Value{Value: 2 * req.(*wrapperspb.Int64Value).Value}, nil
+		}
+		resp, err := interceptor(context.Background(), &wrapperspb.Int64Value{Value: tt.req}, &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+		if calls != tt.wantCalls {
+			t.Errorf("%s(%d) called the handler %d times, want %d", tt.method, tt.req, calls, tt.wantCalls)
+		}
+		if tt.fail {
+			if err != errFailed {
+				t.Errorf("%s(%d) = %v, want %v", tt.method, tt.req, err, errFailed)
+			}
+			continue
+		}
+		if err != nil || resp.(*wrapperspb.Int64Value).Value != 2*tt.req {
+			t.Errorf("%s(%d) = %v, %v, want %d", tt.method, tt.req, resp, err, 2*tt.req)
+		}
+		// Hits get copies, so later interceptors cannot alter the cache.
+		if tt.wantCalls == 0 && resp == shared {
+			t.Errorf("%s(%d) returned the cached response itself", tt.method, tt.req)
+		}
+		shared = resp.(proto.Message)
+	}
+}
+
+func TestUnaryMaxBytes(t *testing.T) {
+	i := &Interceptor{Cache: New(10, 0), Unary: []string{"/test/Cached"}, MaxBytes: 5}
+	interceptor := i.UnaryServerInterceptor()
+	tests := []struct {
+		req       int64
+		wantCalls int
+	}{
+		// The request and the response, 2*req, take 2 bytes.
+		{1, 1},
+		{1, 0},
+		// The response takes 6 bytes.
+		{1 << 27, 1},
+		{1 << 27, 1},
+		// The request takes 7 bytes.
+		{1 << 35, 1},
+		{1 << 35, 1},
+	}
+	for _, tt := range tests {
+		calls := 0
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+			calls++
+			return &wrapperspb.Int64Value{Value: 2 * req.(*wrapperspb.Int64Value).Value}, nil
+		}
+		resp, err := interceptor(context.Background(), &wrapperspb.Int64Value{Value: tt.req}, &grpc.UnaryServerInfo{FullMethod: "/test/Cached"}, handler)
+		if err != nil || resp.(*wrapperspb.Int64Value).Value != 2*tt.req {
+			t.Errorf("Cached(%d) = %v, %v, want %d", tt.req, resp, err, 2*tt.req)
+		}
+		if calls != tt.wantCalls {
+			t.Errorf("Cached(%d) called the handler %d times, want %d", tt.req, calls, tt.wantCalls)
+		}
+	}
+	if i.Cache.Len() != 1 {
+		t.Errorf("Len() = %d, want only the small response cached", i.Cache.Len())
+	}
+}
+
+// fakeStream is a server stream receiving a request and recording the
+// responses.
+type fakeStream struct {
+	grpc.ServerStream
+	req  proto.Message
+	sent []int64
+}
+
+func (s *fakeStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *fakeStream) RecvMsg(m interface{}) error {
+	if s.req == nil {
+		return io.EOF
+	}
+	proto.Merge(m.(proto.Message), s.req)
+	s.req = nil
+	return nil
+}
+
+func (s *fakeStream) SendMsg(m interface{}) error {
+	s.sent = append(s.sent, m.(*wrapperspb.Int64Value).Value)
+	return nil
+}
+
+func TestStreamServerInterceptor(t *testing.T) {
+	i := &Interceptor{
+		Cache: New(10, 0),
+		Streams: map[string]func() proto.Message{
+			"/test/Cached": func() proto.Message { return new(wrapperspb.Int64Value) },
+		},
+		Bypass: func(req interface{}) bool { return req.(*wrapperspb.Int64Value).Value < 0 },
+		// Up to 5 responses of 2 bytes.
+		MaxBytes: 10,
+	}
+	interceptor := i.StreamServerInterceptor()
+	tests := []struct {
+		method    string
+		req       int64
+		wantCalls int
+	}{
+		{"/test/Cached", 3, 1},
+		{"/test/Cached", 3, 0},
+		{"/test/Cached", 2, 1},
+		{"/test/Other", 3, 1},
+		{"/test/Cached", -3, 1},
+		{"/test/Cached", -3, 1},
+		{"/test/Cached", 5, 1},
+		{"/test/Cached", 5, 0},
+		{"/test/Cached", 6, 1},
+		{"/test/Cached", 6, 1},
+	}
+	for _, tt := range tests {
+		calls := 0
+		// The handler streams n, n-1, ..., 1 for the request n, or its
+		// absolute value.
+		handler := func(srv interface{}, stream grpc.ServerStream) error {
+			calls++
+			req := new(wrapperspb.Int64Value)
+			if err := stream.RecvMsg(req); err != nil {
+				return err
+			}
+			n := req.Value
+			if n < 0 {
+				n = -n
+			}
+			for ; n > 0; n-- {
+				if err := stream.SendMsg(&wrapperspb.Int64Value{Value: n}); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+		stream := &fakeStream{req: &wrapperspb.Int64Value{Value: tt.req}}
+		info := &grpc.StreamServerInfo{FullMethod: tt.method, IsServerStream: true}
+		if err := interceptor(nil, stream, info, handler); err != nil {
+			t.Errorf("%s(%d) failed: %v", tt.method, tt.req, err)
+		}
+		if calls != tt.wantCalls {
+			t.Errorf("%s(%d) called the handler %d times, want %d", tt.method, tt.req, calls, tt.wantCalls)
+		}
+		n := tt.req
+		if n < 0 {
+			n = -n
+		}
+		if len(stream.sent) != int(n) || stream.sent[0] != n || stream.sent[n-1] != 1 {
+			t.Errorf("%s(%d) sent %v", tt.method, tt.req, stream.sent)
+		}
+	}
+}
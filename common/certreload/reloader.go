@@ -0,0 +1,196 @@
+// Package certreload keeps the TLS certificates of a server up to date with
+// the files on disk, so rotating them does not require a restart.
+package certreload
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync/atomic"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	log "github.com/sirupsen/logrus"
+)
+
+var (
+	reloadsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "tls_certificate_reloads_total",
+		Help: "Number of attempts to reload the TLS certificates, by result.",
+	}, []string{"result"})
+	expiryTimestamp = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "tls_certificate_expiry_timestamp_seconds",
+		Help: "Expiry time of the serving certificate in seconds since the epoch.",
+	})
+)
+
+// keyPair is the certificate material in use at a point in time.
+type keyPair struct {
+	cert    *tls.Certificate
+	clients *x509.CertPool
+}
+
+// Reloader serves the certificate, key and CA bundle from files and swaps
+// them when the files change. Only new handshakes see the new certificates,
+// established connections and their streams are left untouched.
+type Reloader struct {
+	certFile, keyFile, caFile string
+
+	current atomic.Value // *keyPair
+	stamps  map[string]stamp
+}
+
+// stamp identifies a version of a file.
+type stamp struct {
+	modTime time.Time
+	size    int64
+}
+
+// New loads the certificate and key, and the CA bundle used to verify clients
+// when caFile is not empty.
+func New(certFile, keyFile, caFile string) (*Reloader, error) {
+	r := &Reloader{certFile: certFile, keyFile: keyFile, caFile: caFile}
+	r.stamps = r.stat()
+	kp, err := r.load()
+	if err != nil {
+		return nil, err
+	}
+	r.current.Store(kp)
+	return r, nil
+}
+
+func (r *Reloader) files() []string {
+	files := []string{r.certFile, r.keyFile}
+	if r.caFile != "" {
+		files = append(files, r.caFile)
+	}
+	return files
+}
+
+func (r *Reloader) stat() map[string]stamp {
+	stamps := make(map[string]stamp)
+	for _, f := range r.files() {
+		if fi, err := os.Stat(f); err == nil {
+			stamps[f] = stamp{modTime: fi.ModTime(), size: fi.Size()}
+		}
+	}
+	return stamps
+}
+
+func (r *Reloader) load() (*keyPair, error) {
+	cert, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("loading certificates: %v", err)
+	}
+	leaf, err := x509.ParseCertificate(cert.Certificate[0])
+	if err != nil {
+		return nil, fmt.Errorf("parsing certificate: %v", err)
+	}
+	cert.Leaf = leaf
+	kp := &keyPair{cert: &cert}
+
+	if r.caFile != "" {
+		pem, err := ioutil.ReadFile(r.caFile)
+		if err != nil {
+			return nil, fmt.Errorf("loading trust certificates: %v", err)
+		}
+		kp.clients = x509.NewCertPool()
+		if !kp.clients.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in %s", r.caFile)
+		}
+	}
+	expiryTimestamp.Set(float64(leaf.NotAfter.Unix()))
+	return kp, nil
+}
+
+// Reload loads the files again and swaps them in. On failure the previous
+// certificates stay in use.
+func (r *Reloader) Reload() error {
+	kp, err := r.load()
+	if err != nil {
+		reloadsTotal.WithLabelValues("failure").Inc()
+		log.Errorf("Failed to reload TLS certificates, keeping the previous ones: %v", err)
+		return err
+	}
+	r.current.Store(kp)
+	reloadsTotal.WithLabelValues("success").Inc()
+	log.Infof("Reloaded TLS certificate %q valid until %v", kp.cert.Leaf.Subject, kp.cert.Leaf.NotAfter)
+	return nil
+}
+
+// Watch checks the files every interval and reloads them when any of them
+// changed. It returns when stop is closed.
+func (r *Reloader) Watch(interval time.Duration, stop <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+		stamps := r.stat()
+		if changed(r.stamps, stamps) {
+			// Remember the new versions even when the reload fails, e.g. a
+			// certificate written before its key is retried once the key
+			// changes as well.
+			r.stamps = stamps
+			r.Reload()
+		}
+	}
+}
+
+func changed(old, cur map[string]stamp) bool {
+	if len(old) != len(cur) {
+		return true
+	}
+	for f, s := range cur {
+		if o, ok := old[f]; !ok || !o.modTime.Equal(s.modTime) || o.size != s.size {
+			return true
+		}
+	}
+	return false
+}
+
+// ServerConfig returns a TLS config that picks the current certificates on
+// every handshake. With clientAuth on, clients must present a certificate
+// signed by the CA bundle.
+func (r *Reloader) ServerConfig(clientAuth bool) *tls.Config {
+	return &tls.Config{
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			kp := r.current.Load().(*keyPair)
+			conf := &tls.Config{
+				Certificates: []tls.Certificate{*kp.cert},
+				NextProtos:   []string{"h2"},
+			}
+			if clientAuth {
+				conf.ClientCAs = kp.clients
+				conf.ClientAuth = tls.RequireAndVerifyClientCert
+			}
+			return conf, nil
+		},
+	}
+}
+
+// GetClientCertificate presents the current certificate when the server
+// itself dials out, e.g. from the REST gateway.
+func (r *Reloader) GetClientCertificate(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	return r.current.Load().(*keyPair).cert, nil
+}
+
+// Check returns an error while the serving certificate is not valid, so the
+// server can report itself as not ready.
+func (r *Reloader) Check() error {
+	leaf := r.current.Load().(*keyPair).cert.Leaf
+	now := time.Now()
+	if now.Before(leaf.NotBefore) {
+		return fmt.Errorf("certificate %q not valid before %v", leaf.Subject, leaf.NotBefore)
+	}
+	if now.After(leaf.NotAfter) {
+		return fmt.Errorf("certificate %q expired on %v", leaf.Subject, leaf.NotAfter)
+	}
+	return nil
+}
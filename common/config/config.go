@@ -0,0 +1,512 @@
+// Package config loads the settings shared by the gRPC servers.
+//
+// Every setting can come from, in increasing order of precedence, the built-in
+// defaults, a YAML (or JSON) file, environment variables and command line
+// flags. The file is selected with the -config flag or the <PREFIX>_CONFIG
+// environment variable, e.g.
+//
+//	listen_addr: 0.0.0.0:50051
+//	gateway_addr: 0.0.0.0:8081
+//	metrics_addr: 0.0.0.0:9090
+//	log_level: debug
+//	log_format: json
+//	log_payloads: true
+//	redact_fields: [greet.Greeting.last_name]
+//	drain_timeout: 30s
+//	tls:
+//	  enabled: true
+//	  cert_file: ssl/server.crt
+//	  key_file: ssl/server.pem
+//	  ca_file: ssl/ca.crt
+//	  client_auth: true
+//	  allowed_clients: [greet-client, spiffe://acme.com/billing/*]
+//	  reload_interval: 30s
+//	auth:
+//	  enabled: true
+//	  key_file: auth/keys.yaml
+//	  audience: calc
+//	  policy:
+//	    rules:
+//	      /calc.Calculator/PrimeDecompose: [calc.factor]
+//	      /calc.Calculator/*: [calc.use]
+//	tracing:
+//	  exporter: otlp
+//	  endpoint: otel-collector:4317
+//	  insecure: true
+//	limits:
+//	  max_recv_msg_size: 4194304
+//	  unary_timeout: 10s
+//	cache:
+//	  size: 10000
+//	  ttl: 10m
+//	rate_limit:
+//	  enabled: true
+//	  limits_file: ratelimit.yaml
+//	  api_key_header: x-api-key
+//	  api_keys_file: api-keys.yaml
+//
+// and the same setting is overridden by CALC_TLS_CERT_FILE or -tls-cert-file.
+package config
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"strings"
+	"time"
+
+	"grpc-course/common/auth"
+	"grpc-course/common/certreload"
+	"grpc-course/common/identity"
+	"grpc-course/common/logging"
+	"grpc-course/common/ratelimit"
+	"grpc-course/common/tracing"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// Config holds the settings of a server.
+type Config struct {
+	ListenAddr  string `yaml:"listen_addr"`
+	GatewayAddr string `yaml:"gateway_addr"`
+	MetricsAddr string `yaml:"metrics_addr"`
+	LogLevel    string `yaml:"log_level"`
+	LogFormat   string `yaml:"log_format"`
+	// LogPayloads adds the requests and responses to the RPC logs, with
+	// the RedactFields hidden.
+	LogPayloads  bool     `yaml:"log_payloads"`
+	RedactFields []string `yaml:"redact_fields"`
+	// DrainTimeout is how long in-flight RPCs get to finish on shutdown.
+	DrainTimeout time.Duration  `yaml:"drain_timeout"`
+	TLS          TLS            `yaml:"tls"`
+	Auth         Auth           `yaml:"auth"`
+	Tracing      tracing.Config `yaml:"tracing"`
+	Limits       Limits         `yaml:"limits"`
+	Cache        Cache          `yaml:"cache"`
+	RateLimit    RateLimit      `yaml:"rate_limit"`
+
+	certs   *certreload.Reloader
+	policy  *auth.Policy
+	clients *ratelimit.Clients
+}
+
+// TLS holds the certificate paths used to serve over TLS.
+type TLS struct {
+	Enabled  bool   `yaml:"enabled"`
+	CertFile string `yaml:"cert_file"`
+	KeyFile  string `yaml:"key_file"`
+	CAFile   string `yaml:"ca_file"`
+	// ClientAuth requires clients to present a certificate signed by the CA
+	// (mutual TLS).
+	ClientAuth bool `yaml:"client_auth"`
+	// AllowedClients lists the client identities permitted to call the
+	// service when ClientAuth is on. Empty permits every verified client.
+	AllowedClients []string `yaml:"allowed_clients"`
+	// ReloadInterval is how often the certificate, key and CA files are
+	// checked for changes. Zero disables reloading.
+	ReloadInterval time.Duration `yaml:"reload_interval"`
+}
+
+// Auth configures the bearer token authentication, see package auth for the
+// policy format.
+type Auth struct {
+	Enabled bool `yaml:"enabled"`
+	// KeyFile is the key set the tokens are verified against.
+	KeyFile string `yaml:"key_file"`
+	// Issuer and Audience, when set, must match the claims of the tokens.
+	Issuer   string      `yaml:"issuer"`
+	Audience string      `yaml:"audience"`
+	Policy   auth.Policy `yaml:"policy"`
+}
+
+// Limits bounds the resources a single RPC may use. Zero means the gRPC
+// default, i.e. no limit for the timeout and the number of streams.
+type Limits struct {
+	MaxRecvMsgSize       int           `yaml:"max_recv_msg_size"`
+	MaxSendMsgSize       int           `yaml:"max_send_msg_size"`
+	MaxConcurrentStreams uint32        `yaml:"max_concurrent_streams"`
+	UnaryTimeout         time.Duration `yaml:"unary_timeout"`
+}
+
+// Cache configures the cache of the responses of deterministic RPCs. A zero
+// size disables it.
+type Cache struct {
+	Size int `yaml:"size"`
+	// TTL is how long a response is kept. Zero keeps it until it is evicted.
+	TTL time.Duration `yaml:"ttl"`
+}
+
+// RateLimit configures the per-client rate limits, see package ratelimit for
+// the format of the limits file.
+type RateLimit struct {
+	Enabled    bool   `yaml:"enabled"`
+	LimitsFile string `yaml:"limits_file"`
+	// ReloadInterval is how often the limits file is checked for changes.
+	ReloadInterval time.Duration `yaml:"reload_interval"`
+	// APIKeyHeader is the metadata header telling apart the clients without
+	// a token. Empty tells them apart by certificate or address only.
+	APIKeyHeader string `yaml:"api_key_header"`
+	// APIKeysFile lists the API keys accepted in APIKeyHeader, see
+	// ratelimit.LoadAPIKeys. Other keys are ignored.
+	APIKeysFile string `yaml:"api_keys_file"`
+}
+
+// Defaults returns the configuration used when nothing overrides it.
+func Defaults() *Config {
+	return &Config{
+		ListenAddr:   "0.0.0.0:50051",
+		LogLevel:     "info",
+		LogFormat:    logging.FormatJSON,
+		DrainTimeout: 30 * time.Second,
+		Tracing:      tracing.DefaultConfig(),
+		TLS: TLS{
+			CertFile:       "ssl/server.crt",
+			KeyFile:        "ssl/server.pem",
+			ReloadInterval: 10 * time.Second,
+		},
+		RateLimit: RateLimit{
+			ReloadInterval: 10 * time.Second,
+		},
+	}
+}
+
+// Load builds the configuration of the server from defaults, the config file,
+// the environment variables starting with prefix and the command line args,
+// and validates the result.
+func Load(prefix string, defaults *Config, args []string) (*Config, error) {
+	cfg := *defaults
+	prefix = strings.ToUpper(prefix) + "_"
+
+	fs := flag.NewFlagSet(os.Args[0], flag.ContinueOnError)
+	configFile := fs.String("config", os.Getenv(prefix+"CONFIG"), "path of a YAML or JSON config file")
+	values := make([]*flagValue, len(settings))
+	for i, s := range settings {
+		values[i] = &flagValue{boolean: s.boolean}
+		fs.Var(values[i], s.flag, s.usage)
+	}
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+
+	if *configFile != "" {
+		if err := readFile(*configFile, &cfg); err != nil {
+			return nil, err
+		}
+	}
+
+	var problems []string
+	for i, s := range settings {
+		if v, ok := os.LookupEnv(prefix + s.env()); ok {
+			if err := s.set(&cfg, v); err != nil {
+				problems = append(problems, fmt.Sprintf("%s%s: %v", prefix, s.env(), err))
+			}
+		}
+		if values[i].isSet {
+			if err := s.set(&cfg, values[i].value); err != nil {
+				problems = append(problems, fmt.Sprintf("-%s: %v", s.flag, err))
+			}
+		}
+	}
+	if len(problems) > 0 {
+		return nil, &ValidationError{Problems: problems}
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return &cfg, nil
+}
+
+func readFile(path string, cfg *Config) error {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("reading config file: %v", err)
+	}
+	// JSON is valid YAML, so one decoder covers both formats.
+	if err := yaml.UnmarshalStrict(data, cfg); err != nil {
+		return fmt.Errorf("parsing config file %s: %v", path, err)
+	}
+	return nil
+}
+
+// ValidationError lists every problem found in a configuration.
+type ValidationError struct {
+	Problems []string
+}
+
+func (e *ValidationError) Error() string {
+	return "invalid configuration:\n  " + strings.Join(e.Problems, "\n  ")
+}
+
+// Validate checks that the configuration is usable and reports all problems
+// at once.
+func (c *Config) Validate() error {
+	var problems []string
+	if _, _, err := net.SplitHostPort(c.ListenAddr); err != nil {
+		problems = append(problems, fmt.Sprintf("listen_addr: %v", err))
+	}
+	if c.GatewayAddr != "" {
+		if _, _, err := net.SplitHostPort(c.GatewayAddr); err != nil {
+			problems = append(problems, fmt.Sprintf("gateway_addr: %v", err))
+		}
+	}
+	if c.MetricsAddr != "" {
+		if _, _, err := net.SplitHostPort(c.MetricsAddr); err != nil {
+			problems = append(problems, fmt.Sprintf("metrics_addr: %v", err))
+		}
+	}
+	if _, err := log.ParseLevel(c.LogLevel); err != nil {
+		problems = append(problems, fmt.Sprintf("log_level: %v", err))
+	}
+	if c.LogFormat != logging.FormatJSON && c.LogFormat != logging.FormatText {
+		problems = append(problems, fmt.Sprintf("log_format: unknown format %q", c.LogFormat))
+	}
+	if c.TLS.Enabled {
+		problems = append(problems, checkFile("tls.cert_file", c.TLS.CertFile)...)
+		problems = append(problems, checkFile("tls.key_file", c.TLS.KeyFile)...)
+	}
+	if c.TLS.CAFile != "" || c.TLS.ClientAuth {
+		problems = append(problems, checkFile("tls.ca_file", c.TLS.CAFile)...)
+	}
+	if c.TLS.ClientAuth && !c.TLS.Enabled {
+		problems = append(problems, "tls.client_auth: requires TLS to be enabled")
+	}
+	if len(c.TLS.AllowedClients) > 0 && !c.TLS.ClientAuth {
+		problems = append(problems, "tls.allowed_clients: requires tls.client_auth")
+	}
+	if c.DrainTimeout < 0 {
+		problems = append(problems, "drain_timeout: must not be negative")
+	}
+	if c.Auth.Enabled {
+		if c.Auth.KeyFile == "" {
+			problems = append(problems, "auth.key_file: is required when auth is enabled")
+		} else if _, err := os.Stat(c.Auth.KeyFile); err != nil {
+			problems = append(problems, fmt.Sprintf("auth.key_file: %v", err))
+		}
+	}
+	if err := c.Tracing.Validate(); err != nil {
+		problems = append(problems, fmt.Sprintf("tracing: %v", err))
+	}
+	if c.TLS.ReloadInterval < 0 {
+		problems = append(problems, "tls.reload_interval: must not be negative")
+	}
+	if c.Limits.MaxRecvMsgSize < 0 {
+		problems = append(problems, "limits.max_recv_msg_size: must not be negative")
+	}
+	if c.Limits.MaxSendMsgSize < 0 {
+		problems = append(problems, "limits.max_send_msg_size: must not be negative")
+	}
+	if c.Limits.UnaryTimeout < 0 {
+		problems = append(problems, "limits.unary_timeout: must not be negative")
+	}
+	if c.Cache.Size < 0 {
+		problems = append(problems, "cache.size: must not be negative")
+	}
+	if c.Cache.TTL < 0 {
+		problems = append(problems, "cache.ttl: must not be negative")
+	}
+	if c.RateLimit.Enabled {
+		if c.RateLimit.LimitsFile == "" {
+			problems = append(problems, "rate_limit.limits_file: is required when rate limiting is enabled")
+		} else if _, err := ratelimit.LoadLimits(c.RateLimit.LimitsFile); err != nil {
+			problems = append(problems, fmt.Sprintf("rate_limit.limits_file: %v", err))
+		}
+		if c.RateLimit.ReloadInterval <= 0 {
+			problems = append(problems, "rate_limit.reload_interval: must be positive")
+		}
+	}
+	if c.RateLimit.APIKeyHeader != "" && c.RateLimit.APIKeysFile == "" {
+		problems = append(problems, "rate_limit.api_keys_file: is required when api_key_header is set")
+	}
+	if c.RateLimit.APIKeysFile != "" {
+		if c.RateLimit.APIKeyHeader == "" {
+			problems = append(problems, "rate_limit.api_keys_file: requires rate_limit.api_key_header")
+		}
+		if _, err := ratelimit.LoadAPIKeys(c.RateLimit.APIKeysFile); err != nil {
+			problems = append(problems, fmt.Sprintf("rate_limit.api_keys_file: %v", err))
+		}
+	}
+	if len(problems) > 0 {
+		return &ValidationError{Problems: problems}
+	}
+	return nil
+}
+
+func checkFile(name, path string) []string {
+	if path == "" {
+		return []string{name + ": is required when TLS is enabled"}
+	}
+	if _, err := os.Stat(path); err != nil {
+		return []string{fmt.Sprintf("%s: %v", name, err)}
+	}
+	return nil
+}
+
+// Level returns the parsed log level. The configuration must be valid.
+func (c *Config) Level() log.Level {
+	lvl, _ := log.ParseLevel(c.LogLevel)
+	return lvl
+}
+
+// LogOptions returns the options of the RPC logging interceptors.
+func (c *Config) LogOptions() logging.Options {
+	return logging.Options{Payloads: c.LogPayloads, Redact: c.RedactFields}
+}
+
+// ServerOptions returns the gRPC server options for the TLS, auth, rate limit
+// and limits settings. With client authentication on, the callers of service
+// are checked against the allowed clients.
+func (c *Config) ServerOptions(service string) ([]grpc.ServerOption, error) {
+	var opts []grpc.ServerOption
+	if c.TLS.Enabled {
+		caFile := ""
+		if c.TLS.ClientAuth {
+			caFile = c.TLS.CAFile
+		}
+		certs, err := certreload.New(c.TLS.CertFile, c.TLS.KeyFile, caFile)
+		if err != nil {
+			return nil, err
+		}
+		if c.TLS.ReloadInterval > 0 {
+			go certs.Watch(c.TLS.ReloadInterval, nil)
+		}
+		c.certs = certs
+
+		if c.TLS.ClientAuth {
+			allowlist := &identity.Allowlist{Service: service, Allowed: c.TLS.AllowedClients}
+			opts = append(opts,
+				grpc.ChainUnaryInterceptor(allowlist.UnaryServerInterceptor()),
+				grpc.ChainStreamInterceptor(allowlist.StreamServerInterceptor()),
+			)
+		}
+		opts = append(opts, grpc.Creds(credentials.NewTLS(certs.ServerConfig(c.TLS.ClientAuth))))
+	}
+	if c.Auth.Enabled {
+		keys, err := auth.LoadKeySet(c.Auth.KeyFile)
+		if err != nil {
+			return nil, err
+		}
+		// Health checks come from load balancers and orchestrators without
+		// tokens.
+		policy := c.Auth.Policy
+		policy.Public = append(policy.Public, healthMethods...)
+		c.policy = &policy
+		authenticator := &auth.Authenticator{
+			Verifier: &auth.Verifier{Keys: keys, Issuer: c.Auth.Issuer, Audience: c.Auth.Audience},
+			Policy:   &policy,
+		}
+		opts = append(opts,
+			grpc.ChainUnaryInterceptor(authenticator.UnaryServerInterceptor()),
+			grpc.ChainStreamInterceptor(authenticator.StreamServerInterceptor()),
+		)
+	}
+	c.clients = &ratelimit.Clients{APIKeyHeader: c.RateLimit.APIKeyHeader}
+	if c.RateLimit.APIKeysFile != "" {
+		keys, err := ratelimit.LoadAPIKeys(c.RateLimit.APIKeysFile)
+		if err != nil {
+			return nil, err
+		}
+		c.clients.APIKeys = keys
+	}
+	if c.RateLimit.Enabled {
+		limiter, err := ratelimit.New(c.RateLimit.LimitsFile)
+		if err != nil {
+			return nil, err
+		}
+		limiter.Clients = *c.clients
+		limiter.Exempt = healthMethods
+		go limiter.Watch(c.RateLimit.ReloadInterval, nil)
+		opts = append(opts, limiter.ServerOptions()...)
+	}
+	if c.Limits.MaxRecvMsgSize > 0 {
+		opts = append(opts, grpc.MaxRecvMsgSize(c.Limits.MaxRecvMsgSize))
+	}
+	if c.Limits.MaxSendMsgSize > 0 {
+		opts = append(opts, grpc.MaxSendMsgSize(c.Limits.MaxSendMsgSize))
+	}
+	if c.Limits.MaxConcurrentStreams > 0 {
+		opts = append(opts, grpc.MaxConcurrentStreams(c.Limits.MaxConcurrentStreams))
+	}
+	if c.Limits.UnaryTimeout > 0 {
+		opts = append(opts, grpc.ChainUnaryInterceptor(timeoutInterceptor(c.Limits.UnaryTimeout)))
+	}
+	return opts, nil
+}
+
+var healthMethods = []string{
+	"/grpc.health.v1.Health/Check",
+	"/grpc.health.v1.Health/Watch",
+}
+
+// ReadinessChecks returns the checks of the dependencies configured by
+// ServerOptions, by name.
+func (c *Config) ReadinessChecks() map[string]func() error {
+	checks := make(map[string]func() error)
+	if c.certs != nil {
+		checks["tls_certificate"] = c.certs.Check
+	}
+	return checks
+}
+
+// AuthPolicy returns the scope policy enforced by ServerOptions, nil when auth
+// is disabled. ServerOptions must be called first.
+func (c *Config) AuthPolicy() *auth.Policy {
+	return c.policy
+}
+
+// Clients returns how ServerOptions tells the callers apart, for limits kept
+// per caller outside of the rate limiter. ServerOptions must be called first.
+func (c *Config) Clients() *ratelimit.Clients {
+	return c.clients
+}
+
+// GatewayDialOptions returns the options the REST gateway uses to dial the
+// gRPC server. With TLS enabled the server certificate is verified against
+// the CA file, or against the certificate itself when it is self-signed.
+// Under client authentication the gateway presents the server certificate,
+// so it must permit client auth usage and be in the allowed clients.
+// ServerOptions must be called first.
+func (c *Config) GatewayDialOptions() ([]grpc.DialOption, error) {
+	if !c.TLS.Enabled {
+		return []grpc.DialOption{grpc.WithInsecure()}, nil
+	}
+	rootFile := c.TLS.CAFile
+	if rootFile == "" {
+		rootFile = c.TLS.CertFile
+	}
+	pool, err := loadCertPool(rootFile)
+	if err != nil {
+		return nil, err
+	}
+	conf := &tls.Config{RootCAs: pool, ServerName: "localhost"}
+	if c.TLS.ClientAuth && c.certs != nil {
+		conf.GetClientCertificate = c.certs.GetClientCertificate
+	}
+	return []grpc.DialOption{grpc.WithTransportCredentials(credentials.NewTLS(conf))}, nil
+}
+
+func loadCertPool(path string) (*x509.CertPool, error) {
+	pem, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("loading trust certificates: %v", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("no certificates found in %s", path)
+	}
+	return pool, nil
+}
+
+// LocalEndpoint returns the address the gRPC server can be dialed on from
+// within the same host.
+func (c *Config) LocalEndpoint() string {
+	_, port, _ := net.SplitHostPort(c.ListenAddr)
+	return net.JoinHostPort("localhost", port)
+}
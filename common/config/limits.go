@@ -0,0 +1,18 @@
+package config
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+// timeoutInterceptor bounds the duration of unary RPCs. A shorter deadline
+// set by the client still applies.
+func timeoutInterceptor(timeout time.Duration) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		return handler(ctx, req)
+	}
+}
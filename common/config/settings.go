@@ -0,0 +1,213 @@
+package config
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// setting is a single configuration value that can be overridden from an
+// environment variable and a command line flag.
+type setting struct {
+	flag    string
+	usage   string
+	boolean bool
+	set     func(c *Config, v string) error
+}
+
+// env returns the environment variable suffix of the setting, e.g.
+// TLS_CERT_FILE for the tls-cert-file flag.
+func (s setting) env() string {
+	return strings.ToUpper(strings.Replace(s.flag, "-", "_", -1))
+}
+
+var settings = []setting{
+	{flag: "listen-addr", usage: "address the gRPC server listens on", set: func(c *Config, v string) error {
+		c.ListenAddr = v
+		return nil
+	}},
+	{flag: "gateway-addr", usage: "address of the REST/JSON gateway, disabled when empty", set: func(c *Config, v string) error {
+		c.GatewayAddr = v
+		return nil
+	}},
+	{flag: "metrics-addr", usage: "address of the Prometheus /metrics endpoint, disabled when empty", set: func(c *Config, v string) error {
+		c.MetricsAddr = v
+		return nil
+	}},
+	{flag: "log-level", usage: "log level (debug, info, warn, error)", set: func(c *Config, v string) error {
+		c.LogLevel = v
+		return nil
+	}},
+	{flag: "log-format", usage: "log format (json, text)", set: func(c *Config, v string) error {
+		c.LogFormat = v
+		return nil
+	}},
+	{flag: "log-payloads", usage: "log the requests and responses of the RPCs", boolean: true, set: func(c *Config, v string) error {
+		b, err := strconv.ParseBool(v)
+		c.LogPayloads = b
+		return err
+	}},
+	{flag: "redact-fields", usage: "comma separated full names of the fields hidden in logged payloads", set: func(c *Config, v string) error {
+		c.RedactFields = splitList(v)
+		return nil
+	}},
+	{flag: "drain-timeout", usage: "how long in-flight RPCs get to finish on shutdown, e.g. 30s", set: func(c *Config, v string) error {
+		d, err := time.ParseDuration(v)
+		c.DrainTimeout = d
+		return err
+	}},
+	{flag: "tls", usage: "serve over TLS", boolean: true, set: func(c *Config, v string) error {
+		b, err := strconv.ParseBool(v)
+		c.TLS.Enabled = b
+		return err
+	}},
+	{flag: "tls-cert-file", usage: "path of the server certificate", set: func(c *Config, v string) error {
+		c.TLS.CertFile = v
+		return nil
+	}},
+	{flag: "tls-key-file", usage: "path of the server private key", set: func(c *Config, v string) error {
+		c.TLS.KeyFile = v
+		return nil
+	}},
+	{flag: "tls-ca-file", usage: "path of the certificate authority bundle", set: func(c *Config, v string) error {
+		c.TLS.CAFile = v
+		return nil
+	}},
+	{flag: "tls-client-auth", usage: "require clients to present a certificate signed by the CA", boolean: true, set: func(c *Config, v string) error {
+		b, err := strconv.ParseBool(v)
+		c.TLS.ClientAuth = b
+		return err
+	}},
+	{flag: "tls-allowed-clients", usage: "comma separated client identities allowed to call the service", set: func(c *Config, v string) error {
+		c.TLS.AllowedClients = splitList(v)
+		return nil
+	}},
+	{flag: "tls-reload-interval", usage: "how often to check the certificate files for changes, 0 disables", set: func(c *Config, v string) error {
+		d, err := time.ParseDuration(v)
+		c.TLS.ReloadInterval = d
+		return err
+	}},
+	{flag: "auth", usage: "require a bearer token on every RPC", boolean: true, set: func(c *Config, v string) error {
+		b, err := strconv.ParseBool(v)
+		c.Auth.Enabled = b
+		return err
+	}},
+	{flag: "auth-key-file", usage: "path of the key set tokens are verified against", set: func(c *Config, v string) error {
+		c.Auth.KeyFile = v
+		return nil
+	}},
+	{flag: "auth-issuer", usage: "required issuer of the tokens", set: func(c *Config, v string) error {
+		c.Auth.Issuer = v
+		return nil
+	}},
+	{flag: "auth-audience", usage: "required audience of the tokens", set: func(c *Config, v string) error {
+		c.Auth.Audience = v
+		return nil
+	}},
+	{flag: "tracing-exporter", usage: "where to export traces: none, stdout, file or otlp", set: func(c *Config, v string) error {
+		c.Tracing.Exporter = v
+		return nil
+	}},
+	{flag: "tracing-endpoint", usage: "host:port of the OTLP collector", set: func(c *Config, v string) error {
+		c.Tracing.Endpoint = v
+		return nil
+	}},
+	{flag: "tracing-insecure", usage: "connect to the OTLP collector without TLS", boolean: true, set: func(c *Config, v string) error {
+		b, err := strconv.ParseBool(v)
+		c.Tracing.Insecure = b
+		return err
+	}},
+	{flag: "tracing-file", usage: "path of the file the file exporter writes to", set: func(c *Config, v string) error {
+		c.Tracing.File = v
+		return nil
+	}},
+	{flag: "tracing-sample-ratio", usage: "fraction of new traces to record", set: func(c *Config, v string) error {
+		f, err := strconv.ParseFloat(v, 64)
+		c.Tracing.SampleRatio = f
+		return err
+	}},
+	{flag: "max-recv-msg-size", usage: "maximum size in bytes of a received message", set: func(c *Config, v string) error {
+		n, err := strconv.Atoi(v)
+		c.Limits.MaxRecvMsgSize = n
+		return err
+	}},
+	{flag: "max-send-msg-size", usage: "maximum size in bytes of a sent message", set: func(c *Config, v string) error {
+		n, err := strconv.Atoi(v)
+		c.Limits.MaxSendMsgSize = n
+		return err
+	}},
+	{flag: "max-concurrent-streams", usage: "maximum number of concurrent streams per connection", set: func(c *Config, v string) error {
+		n, err := strconv.ParseUint(v, 10, 32)
+		c.Limits.MaxConcurrentStreams = uint32(n)
+		return err
+	}},
+	{flag: "unary-timeout", usage: "maximum duration of a unary RPC, e.g. 10s", set: func(c *Config, v string) error {
+		d, err := time.ParseDuration(v)
+		c.Limits.UnaryTimeout = d
+		return err
+	}},
+	{flag: "cache-size", usage: "maximum number of cached responses, 0 disables the cache", set: func(c *Config, v string) error {
+		n, err := strconv.Atoi(v)
+		c.Cache.Size = n
+		return err
+	}},
+	{flag: "cache-ttl", usage: "how long a cached response is kept, 0 keeps it until evicted", set: func(c *Config, v string) error {
+		d, err := time.ParseDuration(v)
+		c.Cache.TTL = d
+		return err
+	}},
+	{flag: "rate-limit", usage: "limit the calls of every client", boolean: true, set: func(c *Config, v string) error {
+		b, err := strconv.ParseBool(v)
+		c.RateLimit.Enabled = b
+		return err
+	}},
+	{flag: "rate-limit-file", usage: "path of the per-method rate limits", set: func(c *Config, v string) error {
+		c.RateLimit.LimitsFile = v
+		return nil
+	}},
+	{flag: "rate-limit-reload-interval", usage: "how often to check the rate limits file for changes", set: func(c *Config, v string) error {
+		d, err := time.ParseDuration(v)
+		c.RateLimit.ReloadInterval = d
+		return err
+	}},
+	{flag: "rate-limit-api-key-header", usage: "metadata header of the API key telling clients apart, ignored when empty", set: func(c *Config, v string) error {
+		c.RateLimit.APIKeyHeader = v
+		return nil
+	}},
+	{flag: "rate-limit-api-keys-file", usage: "path of the API keys accepted in the API key header", set: func(c *Config, v string) error {
+		c.RateLimit.APIKeysFile = v
+		return nil
+	}},
+}
+
+func splitList(v string) []string {
+	var list []string
+	for _, item := range strings.Split(v, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
+
+// flagValue records the raw value of a flag so it can be applied after the
+// config file and the environment.
+type flagValue struct {
+	value   string
+	isSet   bool
+	boolean bool
+}
+
+func (v *flagValue) String() string {
+	return v.value
+}
+
+func (v *flagValue) Set(s string) error {
+	v.value = s
+	v.isSet = true
+	return nil
+}
+
+func (v *flagValue) IsBoolFlag() bool {
+	return v.boolean
+}
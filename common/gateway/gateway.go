@@ -0,0 +1,59 @@
+// Package gateway serves the google.api.http mappings declared in the proto
+// files as a REST/JSON endpoint that proxies every request to the gRPC server.
+package gateway
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"grpc-course/common/logging"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+)
+
+// RegisterFunc registers the generated handlers of a service on the mux,
+// e.g. calcpb.RegisterCalculatorHandlerFromEndpoint.
+type RegisterFunc func(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) error
+
+// headerMatcher forwards the W3C trace context and request ID headers on top
+// of the default ones, so the gRPC server continues the trace of the HTTP
+// caller and logs its request ID.
+func headerMatcher(key string) (string, bool) {
+	switch strings.ToLower(key) {
+	case "traceparent", "tracestate", logging.RequestIDHeader:
+		return strings.ToLower(key), true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+// Serve listens on addr and translates the HTTP/JSON requests into gRPC calls
+// to the server listening on endpoint, dialed with opts. gRPC status codes
+// are mapped to the matching HTTP statuses, e.g. INVALID_ARGUMENT becomes
+// 400 Bad Request. It blocks until ctx is done or the HTTP server fails.
+func Serve(ctx context.Context, addr, endpoint string, opts []grpc.DialOption, register RegisterFunc) error {
+	mux := runtime.NewServeMux(
+		// Keep the proto field names (first_name) and always emit zero values
+		// so the JSON payloads match the proto definitions.
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{OrigName: true, EmitDefaults: true}),
+		runtime.WithIncomingHeaderMatcher(headerMatcher),
+	)
+
+	if err := register(ctx, mux, endpoint, opts); err != nil {
+		return err
+	}
+
+	srv := &http.Server{Addr: addr, Handler: mux}
+	go func() {
+		<-ctx.Done()
+		srv.Shutdown(context.Background())
+	}()
+
+	log.Infof("Serving REST gateway on %s for %s", addr, endpoint)
+	if err := srv.ListenAndServe(); err != http.ErrServerClosed {
+		return err
+	}
+	return nil
+}
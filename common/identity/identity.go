@@ -0,0 +1,86 @@
+// Package identity derives the identity of the caller of an RPC from its
+// verified client certificate and enforces an allowlist of permitted callers.
+package identity
+
+import (
+	"context"
+	"crypto/x509"
+	"strings"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+// Identity describes an authenticated caller.
+type Identity struct {
+	// Subject is the distinguished name of the certificate subject,
+	// e.g. "CN=billing,O=Acme".
+	Subject string
+	// Names holds the common name followed by the DNS, URI and email
+	// subject alternative names of the certificate.
+	Names []string
+}
+
+// FromCertificate returns the identity carried by a client certificate.
+func FromCertificate(cert *x509.Certificate) *Identity {
+	id := &Identity{Subject: cert.Subject.String()}
+	if cn := cert.Subject.CommonName; cn != "" {
+		id.Names = append(id.Names, cn)
+	}
+	id.Names = append(id.Names, cert.DNSNames...)
+	for _, uri := range cert.URIs {
+		id.Names = append(id.Names, uri.String())
+	}
+	id.Names = append(id.Names, cert.EmailAddresses...)
+	return id
+}
+
+// FromPeer returns the identity of the verified client certificate of the
+// connection the RPC arrived on.
+func FromPeer(ctx context.Context) (*Identity, bool) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil, false
+	}
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(info.State.VerifiedChains) == 0 || len(info.State.VerifiedChains[0]) == 0 {
+		return nil, false
+	}
+	return FromCertificate(info.State.VerifiedChains[0][0]), true
+}
+
+// String returns the primary name of the caller, or the subject when the
+// certificate carries no name.
+func (id *Identity) String() string {
+	if len(id.Names) > 0 {
+		return id.Names[0]
+	}
+	return id.Subject
+}
+
+// Matches reports whether any name of the caller, or its subject, is in the
+// allowlist. An entry ending in "*" matches every name with that prefix,
+// e.g. "spiffe://acme.com/billing/*".
+func (id *Identity) Matches(allowed []string) bool {
+	for _, a := range allowed {
+		for _, name := range append([]string{id.Subject}, id.Names...) {
+			if a == name || strings.HasSuffix(a, "*") && strings.HasPrefix(name, strings.TrimSuffix(a, "*")) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+type contextKey struct{}
+
+// NewContext returns a copy of ctx carrying the identity.
+func NewContext(ctx context.Context, id *Identity) context.Context {
+	return context.WithValue(ctx, contextKey{}, id)
+}
+
+// FromContext returns the identity of the caller stored by the interceptors.
+func FromContext(ctx context.Context) (*Identity, bool) {
+	id, ok := ctx.Value(contextKey{}).(*Identity)
+	return id, ok
+}
@@ -0,0 +1,65 @@
+package identity
+
+import (
+	"context"
+	"strings"
+
+	"grpc-course/common/logging"
+
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Allowlist holds the callers permitted to use the methods of a service.
+type Allowlist struct {
+	// Service is the full name of the protected service, e.g.
+	// "calc.Calculator". Methods of other services, like reflection, are
+	// only tagged with the caller identity.
+	Service string
+	// Allowed lists the permitted identities. An empty list permits every
+	// caller with a verified certificate.
+	Allowed []string
+}
+
+func (a *Allowlist) authorize(ctx context.Context, fullMethod string) (context.Context, error) {
+	id, ok := FromPeer(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "a verified client certificate is required")
+	}
+	logging.AddFields(ctx, log.Fields{"client": id.String()})
+	if len(a.Allowed) > 0 && strings.HasPrefix(fullMethod, "/"+a.Service+"/") && !id.Matches(a.Allowed) {
+		log.WithField("client", id.String()).Warnf("Rejected call to %s from %s", fullMethod, id.Subject)
+		return nil, status.Errorf(codes.PermissionDenied, "client %q is not allowed to call %s", id.String(), fullMethod)
+	}
+	log.WithField("client", id.String()).Debugf("Accepted call to %s", fullMethod)
+	return NewContext(ctx, id), nil
+}
+
+// UnaryServerInterceptor stores the caller identity in the context of unary
+// RPCs and rejects the callers missing from the allowlist.
+func (a *Allowlist) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := a.authorize(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor stores the caller identity in the context of
+// streaming RPCs and rejects the callers missing from the allowlist.
+func (a *Allowlist) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := a.authorize(stream.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+		wrapped := grpc_middleware.WrapServerStream(stream)
+		wrapped.WrappedContext = ctx
+		return handler(srv, wrapped)
+	}
+}
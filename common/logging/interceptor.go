@@ -0,0 +1,142 @@
+package logging
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// Logger logs the RPCs of a server.
+type Logger struct {
+	opts     Options
+	redactor *redactor
+}
+
+// New returns a logger for the RPCs.
+func New(opts Options) *Logger {
+	return &Logger{opts: opts, redactor: newRedactor(opts.Redact)}
+}
+
+// ServerOptions returns the logging interceptors.
+func (l *Logger) ServerOptions() []grpc.ServerOption {
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(l.unaryInterceptor),
+		grpc.ChainStreamInterceptor(l.streamInterceptor),
+	}
+}
+
+// start assigns the request ID of the RPC and returns a context carrying the
+// logger of the RPC.
+func (l *Logger) start(ctx context.Context, fullMethod string) (context.Context, *rpcEntry) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	requestID := ""
+	if v := md.Get(RequestIDHeader); len(v) > 0 && v[0] != "" {
+		requestID = v[0]
+	} else {
+		requestID = newRequestID()
+	}
+	grpc.SetHeader(ctx, metadata.Pairs(RequestIDHeader, requestID))
+
+	fields := log.Fields{
+		"grpc.method": fullMethod,
+		"request_id":  requestID,
+	}
+	if p, ok := peer.FromContext(ctx); ok {
+		fields["peer"] = p.Addr.String()
+	}
+	if sc := trace.SpanContextFromContext(ctx); sc.HasTraceID() {
+		fields["trace_id"] = sc.TraceID().String()
+	}
+	entry := &rpcEntry{entry: log.WithFields(fields)}
+	return context.WithValue(ctx, entryKey{}, entry), entry
+}
+
+func finish(entry *rpcEntry, start time.Time, err error, fields log.Fields, msg string) {
+	st := status.Convert(err)
+	fields["grpc.code"] = st.Code().String()
+	fields["grpc.duration_ms"] = float64(time.Since(start).Microseconds()) / 1000
+	if err != nil {
+		fields["error"] = st.Message()
+	}
+	entry.get().WithFields(fields).Log(levelFor(st.Code()), msg)
+}
+
+func (l *Logger) unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	ctx, entry := l.start(ctx, info.FullMethod)
+	start := time.Now()
+	resp, err := handler(ctx, req)
+
+	fields := log.Fields{"request_size": size(req)}
+	if l.opts.Payloads {
+		fields["request"] = l.redactor.payload(req)
+	}
+	if err == nil {
+		fields["response_size"] = size(resp)
+		if l.opts.Payloads {
+			fields["response"] = l.redactor.payload(resp)
+		}
+	}
+	finish(entry, start, err, fields, "Finished unary call")
+	return resp, err
+}
+
+func (l *Logger) streamInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, entry := l.start(stream.Context(), info.FullMethod)
+	ls := &loggingStream{ServerStream: stream, ctx: ctx, entry: entry, logger: l}
+	start := time.Now()
+	err := handler(srv, ls)
+
+	finish(entry, start, err, log.Fields{
+		"msgs_received": atomic.LoadInt64(&ls.received),
+		"msgs_sent":     atomic.LoadInt64(&ls.sent),
+		"request_size":  atomic.LoadInt64(&ls.receivedBytes),
+		"response_size": atomic.LoadInt64(&ls.sentBytes),
+	}, "Finished streaming call")
+	return err
+}
+
+// loggingStream counts the messages and bytes of a stream and logs the
+// messages when payload logging is on.
+type loggingStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	entry  *rpcEntry
+	logger *Logger
+
+	received, sent, receivedBytes, sentBytes int64
+}
+
+func (s *loggingStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *loggingStream) SendMsg(m interface{}) error {
+	err := s.ServerStream.SendMsg(m)
+	if err == nil {
+		atomic.AddInt64(&s.sent, 1)
+		atomic.AddInt64(&s.sentBytes, int64(size(m)))
+		if s.logger.opts.Payloads {
+			s.entry.get().WithField("response", s.logger.redactor.payload(m)).Debug("Sent stream message")
+		}
+	}
+	return err
+}
+
+func (s *loggingStream) RecvMsg(m interface{}) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err == nil {
+		atomic.AddInt64(&s.received, 1)
+		atomic.AddInt64(&s.receivedBytes, int64(size(m)))
+		if s.logger.opts.Payloads {
+			s.entry.get().WithField("request", s.logger.redactor.payload(m)).Debug("Received stream message")
+		}
+	}
+	return err
+}
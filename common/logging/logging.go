@@ -0,0 +1,103 @@
+// Package logging writes one structured log entry per RPC on top of logrus,
+// with optional payloads whose sensitive fields are redacted.
+package logging
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+)
+
+// Log formats supported by SetFormat.
+const (
+	FormatJSON = "json"
+	FormatText = "text"
+)
+
+// SetFormat sets the format of the standard logrus logger.
+func SetFormat(format string) error {
+	switch format {
+	case FormatJSON:
+		log.SetFormatter(&log.JSONFormatter{})
+	case FormatText:
+		log.SetFormatter(&log.TextFormatter{})
+	default:
+		return fmt.Errorf("unknown log format %q", format)
+	}
+	return nil
+}
+
+// Options configures the logging interceptors.
+type Options struct {
+	// Payloads adds the requests and responses to the entries. Stream
+	// messages are logged one by one at debug level.
+	Payloads bool
+	// Redact lists the full names of the fields whose values are hidden in
+	// the payloads, e.g. "greet.Greeting.last_name".
+	Redact []string
+}
+
+// RequestIDHeader is the metadata key carrying the request ID. A request ID
+// sent by the client is kept, otherwise a new one is generated. It is sent
+// back in the response headers.
+const RequestIDHeader = "x-request-id"
+
+type entryKey struct{}
+
+// rpcEntry is the logger of an RPC. The interceptors running after the
+// logging ones, e.g. authentication, add the fields they learn to it.
+type rpcEntry struct {
+	mu    sync.Mutex
+	entry *log.Entry
+}
+
+func (e *rpcEntry) get() *log.Entry {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.entry
+}
+
+// FromContext returns the logger of the RPC, carrying its method, its request
+// ID and the fields added with AddFields, or the standard logger outside of
+// an RPC.
+func FromContext(ctx context.Context) *log.Entry {
+	if e, ok := ctx.Value(entryKey{}).(*rpcEntry); ok {
+		return e.get()
+	}
+	return log.NewEntry(log.StandardLogger())
+}
+
+// AddFields adds fields to the logger of the RPC, and so to the entry logged
+// when it finishes. It does nothing outside of an RPC.
+func AddFields(ctx context.Context, fields log.Fields) {
+	if e, ok := ctx.Value(entryKey{}).(*rpcEntry); ok {
+		e.mu.Lock()
+		e.entry = e.entry.WithFields(fields)
+		e.mu.Unlock()
+	}
+}
+
+func newRequestID() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// levelFor returns the level of the entry of an RPC that ended with code.
+// Failures caused by the server are errors, the ones caused by the client
+// are warnings.
+func levelFor(code codes.Code) log.Level {
+	switch code {
+	case codes.OK:
+		return log.InfoLevel
+	case codes.Unknown, codes.Unimplemented, codes.Internal, codes.Unavailable, codes.DataLoss:
+		return log.ErrorLevel
+	default:
+		return log.WarnLevel
+	}
+}
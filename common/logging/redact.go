@@ -0,0 +1,74 @@
+package logging
+
+import (
+	"encoding/json"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const redacted = "[REDACTED]"
+
+// redactor renders messages as JSON with the configured fields hidden.
+type redactor struct {
+	fields map[protoreflect.FullName]bool
+}
+
+func newRedactor(fields []string) *redactor {
+	r := &redactor{fields: make(map[protoreflect.FullName]bool, len(fields))}
+	for _, f := range fields {
+		r.fields[protoreflect.FullName(f)] = true
+	}
+	return r
+}
+
+// payload returns the message as JSON ready to be added to a log entry.
+func (r *redactor) payload(msg interface{}) interface{} {
+	m, ok := msg.(proto.Message)
+	if !ok {
+		return msg
+	}
+	pm := proto.MessageReflect(proto.Clone(m))
+	r.redact(pm)
+	b, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(pm.Interface())
+	if err != nil {
+		return err.Error()
+	}
+	return json.RawMessage(b)
+}
+
+func (r *redactor) redact(m protoreflect.Message) {
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if r.fields[fd.FullName()] {
+			if fd.Kind() == protoreflect.StringKind && fd.Cardinality() != protoreflect.Repeated {
+				m.Set(fd, protoreflect.ValueOfString(redacted))
+			} else {
+				m.Clear(fd)
+			}
+			return true
+		}
+		switch {
+		case fd.IsList() && fd.Message() != nil:
+			list := v.List()
+			for i := 0; i < list.Len(); i++ {
+				r.redact(list.Get(i).Message())
+			}
+		case fd.IsMap() && fd.MapValue().Message() != nil:
+			v.Map().Range(func(_ protoreflect.MapKey, mv protoreflect.Value) bool {
+				r.redact(mv.Message())
+				return true
+			})
+		case !fd.IsList() && !fd.IsMap() && fd.Message() != nil:
+			r.redact(v.Message())
+		}
+		return true
+	})
+}
+
+func size(msg interface{}) int {
+	if m, ok := msg.(proto.Message); ok {
+		return proto.Size(m)
+	}
+	return 0
+}
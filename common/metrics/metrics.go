@@ -0,0 +1,179 @@
+// Package metrics records Prometheus metrics for every RPC of a server and
+// exposes them, with the other registered collectors, on /metrics.
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RPC types used as the grpc_type label.
+const (
+	unary        = "unary"
+	clientStream = "client_stream"
+	serverStream = "server_stream"
+	bidiStream   = "bidi_stream"
+)
+
+var (
+	handledTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "grpc_server_handled_total",
+		Help: "Number of RPCs completed on the server, by status code.",
+	}, []string{"grpc_type", "grpc_service", "grpc_method", "grpc_code"})
+
+	handlingSeconds = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "grpc_server_handling_seconds",
+		Help:    "Latency of the unary RPCs handled by the server.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"grpc_type", "grpc_service", "grpc_method"})
+
+	inFlight = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "grpc_server_in_flight",
+		Help: "Number of RPCs being handled by the server.",
+	}, []string{"grpc_type", "grpc_service", "grpc_method"})
+
+	streamSeconds = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "grpc_server_stream_duration_seconds",
+		Help:    "Duration of the streaming RPCs handled by the server.",
+		Buckets: []float64{0.01, 0.1, 0.5, 1, 5, 10, 30, 60, 300, 900},
+	}, []string{"grpc_type", "grpc_service", "grpc_method"})
+
+	msgReceivedTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "grpc_server_msg_received_total",
+		Help: "Number of stream messages received from the clients.",
+	}, []string{"grpc_type", "grpc_service", "grpc_method"})
+
+	msgSentTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "grpc_server_msg_sent_total",
+		Help: "Number of stream messages sent to the clients.",
+	}, []string{"grpc_type", "grpc_service", "grpc_method"})
+)
+
+// ServerOptions returns the interceptors recording the metrics.
+func ServerOptions() []grpc.ServerOption {
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(unaryInterceptor),
+		grpc.ChainStreamInterceptor(streamInterceptor),
+	}
+}
+
+func unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	service, method := splitMethod(info.FullMethod)
+	gauge := inFlight.WithLabelValues(unary, service, method)
+	gauge.Inc()
+	defer gauge.Dec()
+
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	handlingSeconds.WithLabelValues(unary, service, method).Observe(time.Since(start).Seconds())
+	handledTotal.WithLabelValues(unary, service, method, status.Code(err).String()).Inc()
+	return resp, err
+}
+
+func streamInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	typ := streamType(info.IsClientStream, info.IsServerStream)
+	service, method := splitMethod(info.FullMethod)
+	gauge := inFlight.WithLabelValues(typ, service, method)
+	gauge.Inc()
+	defer gauge.Dec()
+
+	start := time.Now()
+	err := handler(srv, &countingStream{
+		ServerStream: stream,
+		received:     msgReceivedTotal.WithLabelValues(typ, service, method),
+		sent:         msgSentTotal.WithLabelValues(typ, service, method),
+	})
+	streamSeconds.WithLabelValues(typ, service, method).Observe(time.Since(start).Seconds())
+	handledTotal.WithLabelValues(typ, service, method, status.Code(err).String()).Inc()
+	return err
+}
+
+// countingStream counts the messages going through a stream.
+type countingStream struct {
+	grpc.ServerStream
+	received, sent prometheus.Counter
+}
+
+func (s *countingStream) SendMsg(m interface{}) error {
+	err := s.ServerStream.SendMsg(m)
+	if err == nil {
+		s.sent.Inc()
+	}
+	return err
+}
+
+func (s *countingStream) RecvMsg(m interface{}) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err == nil {
+		s.received.Inc()
+	}
+	return err
+}
+
+func streamType(client, server bool) string {
+	switch {
+	case client && server:
+		return bidiStream
+	case client:
+		return clientStream
+	case server:
+		return serverStream
+	}
+	return unary
+}
+
+// splitMethod splits "/calc.Calculator/SquareRoot" into the service and the
+// method names.
+func splitMethod(fullMethod string) (string, string) {
+	fullMethod = strings.TrimPrefix(fullMethod, "/")
+	if i := strings.Index(fullMethod, "/"); i >= 0 {
+		return fullMethod[:i], fullMethod[i+1:]
+	}
+	return "unknown", fullMethod
+}
+
+// Initialize creates the series of every method registered on s, so they are
+// exported with zero values before the first call.
+func Initialize(s *grpc.Server) {
+	for service, info := range s.GetServiceInfo() {
+		for _, m := range info.Methods {
+			typ := streamType(m.IsClientStream, m.IsServerStream)
+			handledTotal.WithLabelValues(typ, service, m.Name, codes.OK.String())
+			inFlight.WithLabelValues(typ, service, m.Name)
+			if typ == unary {
+				handlingSeconds.WithLabelValues(typ, service, m.Name)
+				continue
+			}
+			streamSeconds.WithLabelValues(typ, service, m.Name)
+			msgReceivedTotal.WithLabelValues(typ, service, m.Name)
+			msgSentTotal.WithLabelValues(typ, service, m.Name)
+		}
+	}
+}
+
+// Serve exposes the metrics on addr under /metrics until ctx is done.
+func Serve(ctx context.Context, addr string) error {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+	srv := &http.Server{Addr: addr, Handler: mux}
+	go func() {
+		<-ctx.Done()
+		srv.Shutdown(context.Background())
+	}()
+
+	log.Infof("Serving metrics on %s/metrics", addr)
+	if err := srv.ListenAndServe(); err != http.ErrServerClosed {
+		return err
+	}
+	return nil
+}
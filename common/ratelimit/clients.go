@@ -0,0 +1,100 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"strings"
+
+	"grpc-course/common/auth"
+	"grpc-course/common/identity"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// Clients tells apart the callers of the RPCs by, in order of preference, the
+// subject of their bearer token, their API key, the address of the HTTP
+// client of the REST gateway, the identity of their client certificate and
+// their IP address.
+type Clients struct {
+	// APIKeyHeader is the metadata header carrying the API key of the
+	// clients. Empty ignores API keys.
+	APIKeyHeader string
+	// APIKeys maps the accepted API keys to the names of their clients.
+	// Other keys are ignored, so clients cannot pose as others or get a
+	// fresh limit by making keys up.
+	APIKeys map[string]string
+}
+
+// LoadAPIKeys reads a YAML file mapping client names to their API keys and
+// returns the names by key:
+//
+//	keys:
+//	  billing: 9f2c6e0b1d...
+func LoadAPIKeys(path string) (map[string]string, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading API keys: %v", err)
+	}
+	var file struct {
+		Keys map[string]string `yaml:"keys"`
+	}
+	if err := yaml.UnmarshalStrict(data, &file); err != nil {
+		return nil, fmt.Errorf("parsing API keys %s: %v", path, err)
+	}
+	if len(file.Keys) == 0 {
+		return nil, fmt.Errorf("no keys found in %s", path)
+	}
+	keys := make(map[string]string, len(file.Keys))
+	for name, key := range file.Keys {
+		if key == "" {
+			return nil, fmt.Errorf("empty key of client %q", name)
+		}
+		if other, ok := keys[key]; ok {
+			return nil, fmt.Errorf("clients %q and %q share a key", other, name)
+		}
+		keys[key] = name
+	}
+	return keys, nil
+}
+
+// Client returns the key identifying the caller of the RPC of ctx.
+func (c *Clients) Client(ctx context.Context) string {
+	if claims, ok := auth.ClaimsFromContext(ctx); ok && claims.Subject != "" {
+		return "subject:" + claims.Subject
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	if c.APIKeyHeader != "" {
+		if keys := md.Get(c.APIKeyHeader); len(keys) > 0 {
+			if name, ok := c.APIKeys[keys[0]]; ok {
+				return "api-key:" + name
+			}
+		}
+	}
+	host := "unknown"
+	if p, ok := peer.FromContext(ctx); ok {
+		var err error
+		if host, _, err = net.SplitHostPort(p.Addr.String()); err != nil {
+			host = p.Addr.String()
+		}
+	}
+	// The calls of the REST gateway come from the loopback address, the
+	// address of their HTTP client is the last one it forwards. Under client
+	// authentication the gateway presents the server certificate, so this
+	// comes before the certificate identity.
+	if ip := net.ParseIP(host); ip != nil && ip.IsLoopback() {
+		if fwd := md.Get("x-forwarded-for"); len(fwd) > 0 {
+			addrs := strings.Split(fwd[len(fwd)-1], ",")
+			if last := strings.TrimSpace(addrs[len(addrs)-1]); last != "" {
+				return "peer:" + last
+			}
+		}
+	}
+	if id, ok := identity.FromContext(ctx); ok {
+		return "identity:" + id.String()
+	}
+	return "peer:" + host
+}
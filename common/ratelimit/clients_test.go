@@ -0,0 +1,108 @@
+package ratelimit
+
+import (
+	"context"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"grpc-course/common/auth"
+	"grpc-course/common/identity"
+
+	jwt "github.com/dgrijalva/jwt-go"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+func TestClient(t *testing.T) {
+	clients := &Clients{
+		APIKeyHeader: "x-api-key",
+		APIKeys:      map[string]string{"secret": "billing"},
+	}
+	// call returns the context of an RPC from addr, with the metadata md
+	// and, when set, the token subject and the certificate identity.
+	call := func(addr, subject, id string, md ...string) context.Context {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(md...))
+		if addr != "" {
+			tcpAddr, _ := net.ResolveTCPAddr("tcp", addr)
+			ctx = peer.NewContext(ctx, &peer.Peer{Addr: tcpAddr})
+		}
+		if subject != "" {
+			ctx = auth.NewContext(ctx, &auth.Claims{StandardClaims: jwt.StandardClaims{Subject: subject}})
+		}
+		if id != "" {
+			ctx = identity.NewContext(ctx, &identity.Identity{Names: []string{id}})
+		}
+		return ctx
+	}
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want string
+	}{
+		{"subject first", call("10.0.0.1:5000", "bob", "billing-svc", "x-api-key", "secret"), "subject:bob"},
+		{"known key", call("10.0.0.1:5000", "", "billing-svc", "x-api-key", "secret"), "api-key:billing"},
+		{"unknown key", call("10.0.0.1:5000", "", "", "x-api-key", "made-up"), "peer:10.0.0.1"},
+		{"empty key", call("10.0.0.1:5000", "", "", "x-api-key", ""), "peer:10.0.0.1"},
+		{"identity", call("10.0.0.1:5000", "", "billing-svc"), "identity:billing-svc"},
+		{"address", call("10.0.0.1:5000", "", ""), "peer:10.0.0.1"},
+		{"ipv6 address", call("[2001:db8::1]:5000", "", ""), "peer:2001:db8::1"},
+		// The gateway presents the server certificate, its callers are told
+		// apart by address.
+		{"gateway", call("127.0.0.1:5000", "", "server", "x-forwarded-for", "203.0.113.7"), "peer:203.0.113.7"},
+		{"gateway proxied", call("127.0.0.1:5000", "", "", "x-forwarded-for", "198.51.100.1, 203.0.113.7"), "peer:203.0.113.7"},
+		{"gateway known key", call("127.0.0.1:5000", "", "server", "x-forwarded-for", "203.0.113.7", "x-api-key", "secret"), "api-key:billing"},
+		// Only the gateway is trusted to forward addresses.
+		{"forwarded from remote", call("10.0.0.1:5000", "", "", "x-forwarded-for", "203.0.113.7"), "peer:10.0.0.1"},
+		{"loopback", call("127.0.0.1:5000", "", "server"), "identity:server"},
+		{"no peer", call("", "", ""), "peer:unknown"},
+	}
+	for _, tt := range tests {
+		if got := clients.Client(tt.ctx); got != tt.want {
+			t.Errorf("%s: Client() = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+
+	// Without a header the keys are ignored.
+	noKeys := &Clients{APIKeys: clients.APIKeys}
+	if got := noKeys.Client(call("10.0.0.1:5000", "", "", "x-api-key", "secret")); got != "peer:10.0.0.1" {
+		t.Errorf("Client() without a header = %q, want the address", got)
+	}
+}
+
+func TestLoadAPIKeys(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ratelimit")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "keys.yaml")
+	tests := []struct {
+		data    string
+		want    map[string]string
+		wantErr bool
+	}{
+		{"keys:\n  billing: k1\n  search: k2\n", map[string]string{"k1": "billing", "k2": "search"}, false},
+		{"keys: {}\n", nil, true},
+		{"keys:\n  billing: ''\n", nil, true},
+		{"keys:\n  billing: k1\n  search: k1\n", nil, true},
+		{"key:\n  billing: k1\n", nil, true},
+	}
+	for _, tt := range tests {
+		if err := ioutil.WriteFile(path, []byte(tt.data), 0644); err != nil {
+			t.Fatal(err)
+		}
+		got, err := LoadAPIKeys(path)
+		if (err != nil) != tt.wantErr || len(got) != len(tt.want) {
+			t.Errorf("LoadAPIKeys(%q) = %v, %v, want %v", tt.data, got, err, tt.want)
+			continue
+		}
+		for k, name := range tt.want {
+			if got[k] != name {
+				t.Errorf("LoadAPIKeys(%q) = %v, want %v", tt.data, got, tt.want)
+			}
+		}
+	}
+}
@@ -0,0 +1,303 @@
+// Package ratelimit limits the RPCs each client may make with token buckets,
+// per method, and caps the streams each client may have open at once. The
+// limits come from a file and are reloaded when it changes.
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"grpc-course/common/rpcerr"
+
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+)
+
+// ErrorDomain is the domain of the ErrorInfo details attached to the errors.
+const ErrorDomain = "ratelimit.grpc-course"
+
+// The ErrorInfo reasons of the limited RPCs.
+const (
+	ReasonRateLimited    = "RATE_LIMITED"
+	ReasonTooManyStreams = "TOO_MANY_STREAMS"
+)
+
+// streamRetryDelay is the delay suggested to the clients over their stream
+// cap, as there is no telling when one of their streams ends.
+const streamRetryDelay = time.Second
+
+var (
+	limitedTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "grpc_server_rate_limited_total",
+		Help: "Number of RPCs rejected by the rate limiter, by method and limit (rate or streams).",
+	}, []string{"grpc_method", "limit"})
+	reloadsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "rate_limit_reloads_total",
+		Help: "Number of attempts to reload the rate limits, by result.",
+	}, []string{"result"})
+)
+
+// Limiter enforces the limits of a file on every client, told apart as
+// described at Clients.
+type Limiter struct {
+	Clients
+	// Exempt lists the full names of the methods that are never limited,
+	// e.g. the health checks.
+	Exempt []string
+
+	path   string
+	stamp  stamp
+	limits atomic.Value // *Limits
+
+	mu      sync.Mutex
+	buckets map[bucketKey]*bucket
+	streams map[string]int
+}
+
+// stamp identifies a version of a file.
+type stamp struct {
+	modTime time.Time
+	size    int64
+}
+
+type bucketKey struct {
+	client, entry string
+}
+
+// bucket holds the tokens of a client for a limit entry.
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// New loads the limits from path.
+func New(path string) (*Limiter, error) {
+	l := &Limiter{
+		path:    path,
+		buckets: make(map[bucketKey]*bucket),
+		streams: make(map[string]int),
+	}
+	l.stamp = l.stat()
+	limits, err := LoadLimits(path)
+	if err != nil {
+		return nil, err
+	}
+	l.limits.Store(limits)
+	return l, nil
+}
+
+func (l *Limiter) stat() stamp {
+	fi, err := os.Stat(l.path)
+	if err != nil {
+		return stamp{}
+	}
+	return stamp{modTime: fi.ModTime(), size: fi.Size()}
+}
+
+// Limits returns the limits in use.
+func (l *Limiter) Limits() *Limits {
+	return l.limits.Load().(*Limits)
+}
+
+// Reload loads the file again and swaps the limits in, refilling every
+// bucket. On failure the previous limits stay in use.
+func (l *Limiter) Reload() error {
+	limits, err := LoadLimits(l.path)
+	if err != nil {
+		reloadsTotal.WithLabelValues("failure").Inc()
+		log.Errorf("Failed to reload rate limits, keeping the previous ones: %v", err)
+		return err
+	}
+	l.mu.Lock()
+	l.limits.Store(limits)
+	l.buckets = make(map[bucketKey]*bucket)
+	l.mu.Unlock()
+	reloadsTotal.WithLabelValues("success").Inc()
+	log.Infof("Reloaded rate limits from %s", l.path)
+	return nil
+}
+
+// Watch checks the file every interval and reloads it when it changed. It
+// also drops the buckets that refilled, so idle clients do not pile up. It
+// returns when stop is closed.
+func (l *Limiter) Watch(interval time.Duration, stop <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+		if s := l.stat(); s != l.stamp {
+			l.stamp = s
+			l.Reload()
+		}
+		l.sweep(time.Now())
+	}
+}
+
+func (l *Limiter) sweep(now time.Time) {
+	limits := l.Limits()
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for k, b := range l.buckets {
+		limit, ok := limits.Default, true
+		if k.entry != "default" {
+			limit, ok = limits.Methods[k.entry]
+		}
+		if !ok || b.tokens+now.Sub(b.last).Seconds()*limit.Rate >= limit.burst() {
+			delete(l.buckets, k)
+		}
+	}
+}
+
+// take takes a token of client for fullMethod. When none is left it returns
+// false and how long until one is.
+func (l *Limiter) take(client, fullMethod string, now time.Time) (bool, time.Duration) {
+	entry, limit := l.Limits().limit(fullMethod)
+	if limit.Rate == 0 {
+		return true, 0
+	}
+	burst := limit.burst()
+	k := bucketKey{client: client, entry: entry}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	b, ok := l.buckets[k]
+	if !ok {
+		b = &bucket{tokens: burst, last: now}
+		l.buckets[k] = b
+	}
+	b.tokens = math.Min(burst, b.tokens+now.Sub(b.last).Seconds()*limit.Rate)
+	b.last = now
+	if b.tokens < 1 {
+		wait := time.Duration((1 - b.tokens) / limit.Rate * float64(time.Second))
+		return false, wait
+	}
+	b.tokens--
+	return true, 0
+}
+
+// openStream counts a stream of client, unless the client is at the cap.
+func (l *Limiter) openStream(client string) bool {
+	max := l.Limits().MaxStreams
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if max > 0 && l.streams[client] >= max {
+		return false
+	}
+	l.streams[client]++
+	return true
+}
+
+func (l *Limiter) closeStream(client string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.streams[client]--; l.streams[client] <= 0 {
+		delete(l.streams, client)
+	}
+}
+
+func (l *Limiter) exempt(fullMethod string) bool {
+	for _, m := range l.Exempt {
+		if m == fullMethod {
+			return true
+		}
+	}
+	return false
+}
+
+type callerKey struct{}
+
+// caller is the client of an RPC and the limiter it is limited by.
+type caller struct {
+	limiter *Limiter
+	client  string
+}
+
+// Allow takes a token of the caller of the RPC of ctx for fullMethod, for the
+// calls the RPC makes on its behalf, e.g. the operations of a batch, so they
+// count against the limit of the method they stand for. It fails like the
+// interceptors, and returns nil outside of a rate limited RPC.
+func Allow(ctx context.Context, fullMethod string) error {
+	c, ok := ctx.Value(callerKey{}).(caller)
+	if !ok || c.limiter.exempt(fullMethod) {
+		return nil
+	}
+	return c.limiter.allow(c.client, fullMethod)
+}
+
+// allow takes a token of the client for fullMethod, or returns the
+// RESOURCE_EXHAUSTED error telling it when to retry.
+func (l *Limiter) allow(client, fullMethod string) error {
+	ok, wait := l.take(client, fullMethod, time.Now())
+	if ok {
+		return nil
+	}
+	limitedTotal.WithLabelValues(fullMethod, "rate").Inc()
+	entry, _ := l.Limits().limit(fullMethod)
+	log.WithField("client", client).Debugf("Rate limited call to %s", fullMethod)
+	return rpcerr.ResourceExhausted(ErrorDomain, ReasonRateLimited,
+		fmt.Sprintf("Too many calls to %s, retry in %v", fullMethod, wait.Round(time.Millisecond)),
+		map[string]string{"method": fullMethod, "limit": entry}, wait)
+}
+
+// UnaryServerInterceptor rejects the unary RPCs of the clients out of tokens.
+func (l *Limiter) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if l.exempt(info.FullMethod) {
+			return handler(ctx, req)
+		}
+		client := l.Client(ctx)
+		if err := l.allow(client, info.FullMethod); err != nil {
+			return nil, err
+		}
+		return handler(context.WithValue(ctx, callerKey{}, caller{limiter: l, client: client}), req)
+	}
+}
+
+// StreamServerInterceptor rejects the streaming RPCs of the clients out of
+// tokens or at their stream cap. A stream takes a single token however many
+// messages it carries.
+func (l *Limiter) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if l.exempt(info.FullMethod) {
+			return handler(srv, stream)
+		}
+		ctx := stream.Context()
+		client := l.Client(ctx)
+		if !l.openStream(client) {
+			limitedTotal.WithLabelValues(info.FullMethod, "streams").Inc()
+			max := l.Limits().MaxStreams
+			log.WithField("client", client).Debugf("Rejected stream %s over the cap of %d", info.FullMethod, max)
+			return rpcerr.ResourceExhausted(ErrorDomain, ReasonTooManyStreams,
+				fmt.Sprintf("Too many open streams, at most %d are allowed", max),
+				map[string]string{"method": info.FullMethod, "max_streams": fmt.Sprint(max)}, streamRetryDelay)
+		}
+		defer l.closeStream(client)
+		if err := l.allow(client, info.FullMethod); err != nil {
+			return err
+		}
+		wrapped := grpc_middleware.WrapServerStream(stream)
+		wrapped.WrappedContext = context.WithValue(ctx, callerKey{}, caller{limiter: l, client: client})
+		return handler(srv, wrapped)
+	}
+}
+
+// ServerOptions returns the rate limiting interceptors. They should come after
+// the authentication interceptors, which identify the callers.
+func (l *Limiter) ServerOptions() []grpc.ServerOption {
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(l.UnaryServerInterceptor()),
+		grpc.ChainStreamInterceptor(l.StreamServerInterceptor()),
+	}
+}
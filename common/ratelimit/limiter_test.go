@@ -0,0 +1,158 @@
+package ratelimit
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// newLimiter returns a limiter of limits, without a file.
+func newLimiter(limits *Limits) *Limiter {
+	l := &Limiter{
+		buckets: make(map[bucketKey]*bucket),
+		streams: make(map[string]int),
+	}
+	l.limits.Store(limits)
+	return l
+}
+
+func TestTake(t *testing.T) {
+	l := newLimiter(&Limits{
+		Default: Limit{Rate: 2, Burst: 3},
+		Methods: map[string]Limit{
+			"/s.S/*":        {Rate: 1},
+			"/s.S/Free":     {},
+			"/s.S/Separate": {Rate: 1},
+		},
+	})
+	start := time.Now()
+	tests := []struct {
+		ms       int
+		client   string
+		method   string
+		ok       bool
+		wantWait time.Duration
+	}{
+		// The burst is available at once, then a token every 500ms.
+		{0, "a", "/t.T/M", true, 0},
+		{0, "a", "/t.T/M", true, 0},
+		{0, "a", "/t.T/M", true, 0},
+		{0, "a", "/t.T/M", false, 500 * time.Millisecond},
+		{250, "a", "/t.T/M", false, 250 * time.Millisecond},
+		{500, "a", "/t.T/M", true, 0},
+		{500, "a", "/t.T/M", false, 500 * time.Millisecond},
+		// Other clients have their own buckets.
+		{500, "b", "/t.T/M", true, 0},
+		// The methods falling back to the default share its bucket.
+		{500, "a", "/t.T/N", false, 500 * time.Millisecond},
+		// The bucket holds at most the burst however long it refills.
+		{10000, "a", "/t.T/M", true, 0},
+		{10000, "a", "/t.T/M", true, 0},
+		{10000, "a", "/t.T/M", true, 0},
+		{10000, "a", "/t.T/M", false, 500 * time.Millisecond},
+		// The methods of a service entry share its bucket, apart from the
+		// ones with their own entry.
+		{0, "a", "/s.S/One", true, 0},
+		{0, "a", "/s.S/Two", false, time.Second},
+		{0, "a", "/s.S/Separate", true, 0},
+		// A zero rate does not limit.
+		{0, "a", "/s.S/Free", true, 0},
+		{0, "a", "/s.S/Free", true, 0},
+	}
+	for i, tt := range tests {
+		ok, wait := l.take(tt.client, tt.method, start.Add(time.Duration(tt.ms)*time.Millisecond))
+		if ok != tt.ok || wait != tt.wantWait {
+			t.Errorf("%d: take(%q, %q) at %dms = %v, %v, want %v, %v", i, tt.client, tt.method, tt.ms, ok, wait, tt.ok, tt.wantWait)
+		}
+	}
+}
+
+func TestStreams(t *testing.T) {
+	tests := []struct {
+		max int
+		// ops opens a stream for "+client" and closes one for "-client".
+		ops  []string
+		want []bool
+	}{
+		{2, []string{"+a", "+a", "+a", "+b", "-a", "+a"}, []bool{true, true, false, true, true, true}},
+		{1, []string{"+a", "-a", "+a", "+a"}, []bool{true, true, true, false}},
+		{0, []string{"+a", "+a", "+a"}, []bool{true, true, true}},
+	}
+	for _, tt := range tests {
+		l := newLimiter(&Limits{MaxStreams: tt.max})
+		for i, op := range tt.ops {
+			client := op[1:]
+			if op[0] == '-' {
+				l.closeStream(client)
+				continue
+			}
+			if got := l.openStream(client); got != tt.want[i] {
+				t.Errorf("max %d, %v: open %d = %v, want %v", tt.max, tt.ops, i, got, tt.want[i])
+			}
+		}
+	}
+
+	// Clients without streams are forgotten.
+	l := newLimiter(&Limits{MaxStreams: 1})
+	l.openStream("a")
+	l.closeStream("a")
+	if len(l.streams) != 0 {
+		t.Errorf("streams = %v after the last one closed, want none", l.streams)
+	}
+}
+
+func TestReload(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ratelimit")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "limits.yaml")
+	write := func(data string) {
+		t.Helper()
+		if err := ioutil.WriteFile(path, []byte(data), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	write("default: {rate: 1, burst: 2}\n")
+	l, err := New(path)
+	if err != nil {
+		t.Fatalf("New failed: %v", err)
+	}
+	l.take("a", "/s.S/M", time.Now())
+
+	tests := []struct {
+		name string
+		data string
+	}{
+		{"not yaml", "default: {rate: 1\n"},
+		{"unknown field", "defaults: {rate: 1}\n"},
+		{"negative rate", "default: {rate: -1}\n"},
+		{"bad method", "methods: {s.S/M: {rate: 1}}\n"},
+	}
+	for _, tt := range tests {
+		write(tt.data)
+		old := l.Limits()
+		if err := l.Reload(); err == nil {
+			t.Errorf("%s: Reload succeeded", tt.name)
+		}
+		if l.Limits() != old || len(l.buckets) != 1 {
+			t.Errorf("%s: failed Reload changed the limits to %+v and the buckets to %v", tt.name, l.Limits(), l.buckets)
+		}
+	}
+	os.Remove(path)
+	if err := l.Reload(); err == nil || l.Limits().Default.Rate != 1 {
+		t.Errorf("Reload of a missing file = %v with limits %+v", err, l.Limits())
+	}
+
+	write("default: {rate: 5}\n")
+	if err := l.Reload(); err != nil {
+		t.Fatalf("Reload failed: %v", err)
+	}
+	if l.Limits().Default.Rate != 5 || len(l.buckets) != 0 {
+		t.Errorf("Reload set the limits to %+v and the buckets to %v, want rate 5 and no buckets", l.Limits(), l.buckets)
+	}
+}
@@ -0,0 +1,104 @@
+package ratelimit
+
+import (
+	"fmt"
+	"io/ioutil"
+	"math"
+	"strings"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+// Limits declares what each client is allowed, e.g.
+//
+//	default: {rate: 50, burst: 100}
+//	methods:
+//	  /calc.Calculator/PrimeDecompose: {rate: 5, burst: 10}
+//	  /greet.GreetService/*: {rate: 20}
+//	max_streams: 10
+//
+// A method is limited by the entry of its full name, then by the entry of its
+// service followed by "/*", then by the default. The methods sharing an entry
+// share its tokens, e.g. all the methods of a service limited by "/*".
+type Limits struct {
+	Default Limit            `yaml:"default"`
+	Methods map[string]Limit `yaml:"methods"`
+	// MaxStreams caps the streams each client may have open at once. Zero
+	// does not cap them.
+	MaxStreams int `yaml:"max_streams"`
+}
+
+// Limit is a token bucket refilled at Rate tokens per second and holding at
+// most Burst tokens. Every call takes a token, and so does every call made on
+// its behalf through Allow.
+type Limit struct {
+	// Rate is the sustained number of calls per second. Zero does not limit
+	// the calls.
+	Rate float64 `yaml:"rate"`
+	// Burst is the number of calls allowed at once, the rate rounded up when
+	// unset.
+	Burst int `yaml:"burst"`
+}
+
+func (l Limit) burst() float64 {
+	if l.Burst > 0 {
+		return float64(l.Burst)
+	}
+	return math.Max(1, math.Ceil(l.Rate))
+}
+
+// LoadLimits reads the limits from a YAML (or JSON) file.
+func LoadLimits(path string) (*Limits, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading rate limits: %v", err)
+	}
+	var l Limits
+	if err := yaml.UnmarshalStrict(data, &l); err != nil {
+		return nil, fmt.Errorf("parsing rate limits %s: %v", path, err)
+	}
+	if err := l.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid rate limits %s: %v", path, err)
+	}
+	return &l, nil
+}
+
+// Validate checks that the limits are usable.
+func (l *Limits) Validate() error {
+	var problems []string
+	check := func(name string, limit Limit) {
+		if limit.Rate < 0 || math.IsNaN(limit.Rate) || math.IsInf(limit.Rate, 0) {
+			problems = append(problems, name+": rate must be a non-negative number")
+		}
+		if limit.Burst < 0 {
+			problems = append(problems, name+": burst must not be negative")
+		}
+	}
+	check("default", l.Default)
+	for m, limit := range l.Methods {
+		if !strings.HasPrefix(m, "/") {
+			problems = append(problems, fmt.Sprintf("methods: %q is not a full method name or a service followed by /*", m))
+		}
+		check(m, limit)
+	}
+	if l.MaxStreams < 0 {
+		problems = append(problems, "max_streams: must not be negative")
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("%s", strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// limit returns the limit of the method and the name of its entry.
+func (l *Limits) limit(fullMethod string) (string, Limit) {
+	if limit, ok := l.Methods[fullMethod]; ok {
+		return fullMethod, limit
+	}
+	if i := strings.LastIndex(fullMethod, "/"); i > 0 {
+		if limit, ok := l.Methods[fullMethod[:i]+"/*"]; ok {
+			return fullMethod[:i] + "/*", limit
+		}
+	}
+	return "default", l.Default
+}
@@ -0,0 +1,50 @@
+package ratelimit
+
+import "testing"
+
+func TestLimitFallback(t *testing.T) {
+	limits := &Limits{
+		Default: Limit{Rate: 1},
+		Methods: map[string]Limit{
+			"/calc.Calculator/PrimeDecompose": {Rate: 2},
+			"/calc.Calculator/*":              {Rate: 3},
+			"/greet.GreetService/Greet":       {Rate: 4},
+		},
+	}
+	tests := []struct {
+		method    string
+		wantEntry string
+		wantRate  float64
+	}{
+		{"/calc.Calculator/PrimeDecompose", "/calc.Calculator/PrimeDecompose", 2},
+		{"/calc.Calculator/Sum", "/calc.Calculator/*", 3},
+		{"/greet.GreetService/Greet", "/greet.GreetService/Greet", 4},
+		{"/greet.GreetService/GreetManyTimes", "default", 1},
+		// The service must match exactly, not as a prefix.
+		{"/calc.CalculatorV2/Sum", "default", 1},
+		{"/grpc.health.v1.Health/Check", "default", 1},
+	}
+	for _, tt := range tests {
+		entry, limit := limits.limit(tt.method)
+		if entry != tt.wantEntry || limit.Rate != tt.wantRate {
+			t.Errorf("limit(%q) = %q, %v, want %q, %v", tt.method, entry, limit.Rate, tt.wantEntry, tt.wantRate)
+		}
+	}
+}
+
+func TestBurst(t *testing.T) {
+	tests := []struct {
+		limit Limit
+		want  float64
+	}{
+		{Limit{Rate: 5, Burst: 10}, 10},
+		{Limit{Rate: 5}, 5},
+		{Limit{Rate: 2.5}, 3},
+		{Limit{Rate: 0.1}, 1},
+	}
+	for _, tt := range tests {
+		if got := tt.limit.burst(); got != tt.want {
+			t.Errorf("%+v.burst() = %v, want %v", tt.limit, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,81 @@
+// Package readiness drives the statuses of the standard gRPC health service
+// from the real state of a server.
+package readiness
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// Reporter reports the services of a server as SERVING while its listener is
+// up and every dependency check passes. Once the server drains, the health
+// server is shut down and keeps reporting NOT_SERVING.
+type Reporter struct {
+	Health *health.Server
+	// Services are the full names of the services, e.g. "calc.Calculator".
+	// The overall status of the server, the empty service name, follows
+	// them.
+	Services []string
+	// Checks are the dependencies of the services by name. A check returns
+	// an error while its dependency is not ready.
+	Checks map[string]func() error
+	// Interval is how often the checks run.
+	Interval time.Duration
+}
+
+// NotServing reports every service as NOT_SERVING, e.g. before the listener
+// is up.
+func (r *Reporter) NotServing() {
+	r.set(healthpb.HealthCheckResponse_NOT_SERVING)
+}
+
+func (r *Reporter) set(st healthpb.HealthCheckResponse_ServingStatus) {
+	r.Health.SetServingStatus("", st)
+	for _, svc := range r.Services {
+		r.Health.SetServingStatus(svc, st)
+	}
+}
+
+// Run runs the checks every interval, updating the statuses, until ctx is
+// done. It must be called once the listener is up.
+func (r *Reporter) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.Interval)
+	defer ticker.Stop()
+
+	last := healthpb.HealthCheckResponse_UNKNOWN
+	for {
+		st := healthpb.HealthCheckResponse_SERVING
+		if failed := r.failedChecks(); len(failed) > 0 {
+			st = healthpb.HealthCheckResponse_NOT_SERVING
+			if last != st {
+				log.Warnf("Not ready, failed checks: %v", failed)
+			}
+		} else if last != st {
+			log.Infof("Ready, serving %v", r.Services)
+		}
+		r.set(st)
+		last = st
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (r *Reporter) failedChecks() []string {
+	var failed []string
+	for name, check := range r.Checks {
+		if err := check(); err != nil {
+			failed = append(failed, name+": "+err.Error())
+		}
+	}
+	sort.Strings(failed)
+	return failed
+}
@@ -0,0 +1,77 @@
+// Package recovery keeps a panicking handler from crashing the server: the
+// panic is logged with its stack trace and the RPC fails with INTERNAL.
+package recovery
+
+import (
+	"context"
+	"runtime/debug"
+
+	"grpc-course/common/logging"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var panics = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "grpc_server_panics_recovered_total",
+	Help: "Number of handler panics recovered, by method.",
+}, []string{"grpc_method"})
+
+// recovered logs the panic p of the RPC and returns its INTERNAL status. The
+// panic value is not sent to the client.
+func recovered(ctx context.Context, fullMethod string, p interface{}) error {
+	panics.WithLabelValues(fullMethod).Inc()
+	logging.FromContext(ctx).
+		WithField("stack", string(debug.Stack())).
+		Errorf("Recovered from panic in %s: %v", fullMethod, p)
+	return status.Error(codes.Internal, "internal server error")
+}
+
+// Call calls fn, recovering its panic like the interceptors do. It protects
+// the goroutines a handler starts, which the interceptors do not cover. name
+// identifies fn in the logs and metrics.
+func Call(ctx context.Context, name string, fn func() error) (err error) {
+	defer func() {
+		if p := recover(); p != nil {
+			err = recovered(ctx, name, p)
+		}
+	}()
+	return fn()
+}
+
+// UnaryServerInterceptor recovers the panics of unary handlers.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		defer func() {
+			if p := recover(); p != nil {
+				resp, err = nil, recovered(ctx, info.FullMethod, p)
+			}
+		}()
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor recovers the panics of streaming handlers.
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		defer func() {
+			if p := recover(); p != nil {
+				err = recovered(stream.Context(), info.FullMethod, p)
+			}
+		}()
+		return handler(srv, stream)
+	}
+}
+
+// ServerOptions returns the recovery interceptors. They must come after the
+// logging and metrics interceptors so the recovered RPCs are recorded as
+// INTERNAL.
+func ServerOptions() []grpc.ServerOption {
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(UnaryServerInterceptor()),
+		grpc.ChainStreamInterceptor(StreamServerInterceptor()),
+	}
+}
@@ -0,0 +1,99 @@
+package rpcerr
+
+import (
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Error is a gRPC error with its ErrorInfo, Help and RetryInfo details
+// decoded. Clients get it from Decode and match it with errors.As.
+type Error struct {
+	Code    codes.Code
+	Message string
+	// Reason, Domain and Metadata come from the ErrorInfo detail. Reason is
+	// empty without one.
+	Reason   string
+	Domain   string
+	Metadata map[string]string
+	// Links come from the Help detail.
+	Links []Link
+	// RetryDelay comes from the RetryInfo detail, it is how long to wait
+	// before retrying. Zero without one.
+	RetryDelay time.Duration
+
+	status *status.Status
+}
+
+// Link points to the documentation of an error.
+type Link struct {
+	Description string
+	URL         string
+}
+
+func (e *Error) Error() string {
+	return e.status.Err().Error()
+}
+
+// GRPCStatus returns the status the error was decoded from, so that
+// status.FromError keeps working on it.
+func (e *Error) GRPCStatus() *status.Status {
+	return e.status
+}
+
+// BadRequestError is an error with a BadRequest detail, usually of code
+// INVALID_ARGUMENT. It wraps the *Error holding the other details.
+type BadRequestError struct {
+	Violations []FieldViolation
+
+	err *Error
+}
+
+func (e *BadRequestError) Error() string {
+	return e.err.Error()
+}
+
+// Unwrap returns the *Error of the other details.
+func (e *BadRequestError) Unwrap() error {
+	return e.err
+}
+
+// GRPCStatus returns the status the error was decoded from.
+func (e *BadRequestError) GRPCStatus() *status.Status {
+	return e.err.status
+}
+
+// Decode returns err with its status details decoded into an *Error, itself
+// wrapped in a *BadRequestError when there are field violations. Errors
+// without a status are returned as they are.
+func Decode(err error) error {
+	st, ok := status.FromError(err)
+	if err == nil || !ok {
+		return err
+	}
+	e := &Error{Code: st.Code(), Message: st.Message(), status: st}
+	var violations []FieldViolation
+	for _, d := range st.Details() {
+		switch d := d.(type) {
+		case *errdetails.ErrorInfo:
+			e.Reason, e.Domain, e.Metadata = d.GetReason(), d.GetDomain(), d.GetMetadata()
+		case *errdetails.Help:
+			for _, l := range d.GetLinks() {
+				e.Links = append(e.Links, Link{Description: l.GetDescription(), URL: l.GetUrl()})
+			}
+		case *errdetails.RetryInfo:
+			e.RetryDelay, _ = ptypes.Duration(d.GetRetryDelay())
+		case *errdetails.BadRequest:
+			for _, v := range d.GetFieldViolations() {
+				violations = append(violations, Violation(v.GetField(), v.GetDescription()))
+			}
+		}
+	}
+	if len(violations) > 0 {
+		return &BadRequestError{Violations: violations, err: e}
+	}
+	return e
+}
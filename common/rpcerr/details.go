@@ -0,0 +1,83 @@
+package rpcerr
+
+import (
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// HelpURL is the page documenting the error reasons. The Help detail of an
+// error links to the section of its reason.
+const HelpURL = "https://github.com/daniel-krastev/gRPC-course/blob/master/docs/errors.md"
+
+// FieldViolation describes why a field of a request is invalid. Field is the
+// path of the field, e.g. "greeting.first_name".
+type FieldViolation struct {
+	Field       string
+	Description string
+}
+
+// Violation returns the violation of field.
+func Violation(field, description string) FieldViolation {
+	return FieldViolation{Field: field, Description: description}
+}
+
+// New returns an error of code with msg, whose details are an ErrorInfo of
+// reason, domain and metadata, a BadRequest of the violations, if any, and a
+// Help link to the documentation of reason.
+func New(code codes.Code, domain, reason, msg string, metadata map[string]string, violations ...FieldViolation) error {
+	var extra []proto.Message
+	if len(violations) > 0 {
+		br := &errdetails.BadRequest{}
+		for _, v := range violations {
+			br.FieldViolations = append(br.FieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       v.Field,
+				Description: v.Description,
+			})
+		}
+		extra = append(extra, br)
+	}
+	return withDetails(code, domain, reason, msg, metadata, extra...)
+}
+
+// InvalidArgument returns an INVALID_ARGUMENT error with the details of New.
+func InvalidArgument(domain, reason, msg string, violations ...FieldViolation) error {
+	return New(codes.InvalidArgument, domain, reason, msg, nil, violations...)
+}
+
+// ResourceExhausted returns a RESOURCE_EXHAUSTED error with the details of
+// New and a RetryInfo telling the client to retry after retryDelay.
+func ResourceExhausted(domain, reason, msg string, metadata map[string]string, retryDelay time.Duration) error {
+	return withDetails(codes.ResourceExhausted, domain, reason, msg, metadata, &errdetails.RetryInfo{
+		RetryDelay: ptypes.DurationProto(retryDelay),
+	})
+}
+
+// withDetails returns an error of code with msg, whose details are an
+// ErrorInfo, the extra details and a Help link.
+func withDetails(code codes.Code, domain, reason, msg string, metadata map[string]string, extra ...proto.Message) error {
+	details := []proto.Message{
+		&errdetails.ErrorInfo{
+			Reason:   reason,
+			Domain:   domain,
+			Metadata: metadata,
+		},
+	}
+	details = append(details, extra...)
+	details = append(details, &errdetails.Help{
+		Links: []*errdetails.Help_Link{{
+			Description: "Documentation of " + reason,
+			Url:         HelpURL + "#" + strings.ToLower(reason),
+		}},
+	})
+	st, err := status.New(code, msg).WithDetails(details...)
+	if err != nil {
+		return status.Error(code, msg)
+	}
+	return st.Err()
+}
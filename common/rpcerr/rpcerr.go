@@ -0,0 +1,37 @@
+// Package rpcerr builds the gRPC statuses the handlers return, from the
+// errors of stream.Recv and stream.Send or with standard error details, and
+// decodes those details on the client side.
+package rpcerr
+
+import (
+	"context"
+	"io"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// FromStream returns the status of an RPC whose stream failed with err while
+// doing op, e.g. "receiving" or "sending":
+//
+//   - CANCELED when the client cancelled the RPC or went away,
+//   - DEADLINE_EXCEEDED when the deadline of the RPC expired,
+//   - UNAVAILABLE when the transport closed underneath the stream,
+//   - INTERNAL otherwise.
+//
+// Errors that already carry a status other than UNKNOWN are kept.
+func FromStream(ctx context.Context, op string, err error) error {
+	switch ctx.Err() {
+	case context.Canceled:
+		return status.Errorf(codes.Canceled, "%s: client cancelled the stream", op)
+	case context.DeadlineExceeded:
+		return status.Errorf(codes.DeadlineExceeded, "%s: deadline exceeded", op)
+	}
+	if st, ok := status.FromError(err); ok && st.Code() != codes.Unknown {
+		return err
+	}
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		return status.Errorf(codes.Unavailable, "%s: stream closed: %v", op, err)
+	}
+	return status.Errorf(codes.Internal, "%s: %v", op, err)
+}
@@ -0,0 +1,136 @@
+// Package shutdown stops the gRPC servers gracefully on SIGINT and SIGTERM,
+// giving in-flight RPCs, streams in particular, time to finish.
+package shutdown
+
+import (
+	"context"
+	"net"
+	"os"
+	"os/signal"
+	"strings"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+)
+
+// SignalContext returns a context that is done once the process receives
+// SIGINT or SIGTERM.
+func SignalContext() context.Context {
+	ctx, cancel := context.WithCancel(context.Background())
+	sigc := make(chan os.Signal, 1)
+	signal.Notify(sigc, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		sig := <-sigc
+		log.Infof("Received %v", sig)
+		signal.Stop(sigc)
+		cancel()
+	}()
+	return ctx
+}
+
+// drainPollInterval is how often the drain checks whether the in-flight RPCs
+// finished.
+const drainPollInterval = 50 * time.Millisecond
+
+// Drainer serves a gRPC server until shutdown and then drains it.
+type Drainer struct {
+	// Timeout is how long in-flight RPCs get to finish before the server is
+	// force-stopped.
+	Timeout time.Duration
+	// Health, when set, reports NOT_SERVING as soon as the shutdown starts.
+	Health *health.Server
+
+	inFlight int64
+}
+
+// healthService prefixes the methods of the health service. Its Watch streams
+// only end when the client goes away, so they are not waited for.
+const healthService = "/grpc.health.v1.Health/"
+
+// ServerOptions returns the interceptors counting the in-flight RPCs, except
+// the health checks.
+func (d *Drainer) ServerOptions() []grpc.ServerOption {
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			if strings.HasPrefix(info.FullMethod, healthService) {
+				return handler(ctx, req)
+			}
+			atomic.AddInt64(&d.inFlight, 1)
+			defer atomic.AddInt64(&d.inFlight, -1)
+			return handler(ctx, req)
+		}),
+		grpc.ChainStreamInterceptor(func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			if strings.HasPrefix(info.FullMethod, healthService) {
+				return handler(srv, stream)
+			}
+			atomic.AddInt64(&d.inFlight, 1)
+			defer atomic.AddInt64(&d.inFlight, -1)
+			return handler(srv, stream)
+		}),
+	}
+}
+
+// InFlight returns the number of RPCs being handled.
+func (d *Drainer) InFlight() int64 {
+	return atomic.LoadInt64(&d.inFlight)
+}
+
+// Serve serves s on lis until ctx is done, then drains it. It returns once the
+// server stopped.
+func (d *Drainer) Serve(ctx context.Context, s *grpc.Server, lis net.Listener) error {
+	errc := make(chan error, 1)
+	go func() {
+		errc <- s.Serve(lis)
+	}()
+
+	select {
+	case err := <-errc:
+		return err
+	case <-ctx.Done():
+	}
+	d.drain(s)
+	return <-errc
+}
+
+func (d *Drainer) drain(s *grpc.Server) {
+	log.Infof("Shutting down, draining %d in-flight RPCs for up to %v", d.InFlight(), d.Timeout)
+	if d.Health != nil {
+		d.Health.Shutdown()
+	}
+
+	stopped := make(chan struct{})
+	go func() {
+		s.GracefulStop()
+		close(stopped)
+	}()
+
+	timer := time.NewTimer(d.Timeout)
+	defer timer.Stop()
+	poll := time.NewTicker(drainPollInterval)
+	defer poll.Stop()
+	for {
+		select {
+		case <-stopped:
+			log.Info("All RPCs finished")
+			return
+		case <-poll.C:
+			if d.InFlight() > 0 {
+				continue
+			}
+			// Only health watchers are left, they were told NOT_SERVING.
+			s.Stop()
+			<-stopped
+			log.Info("All RPCs finished")
+			return
+		case <-timer.C:
+			cut := d.InFlight()
+			s.Stop()
+			log.Warnf("Drain timeout of %v elapsed, cut off %d in-flight RPCs", d.Timeout, cut)
+			return
+		}
+	}
+}
@@ -0,0 +1,271 @@
+package tracing
+
+import (
+	"context"
+	"io"
+	"strings"
+	"sync"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const instrumentation = "grpc-course/common/tracing"
+
+// batchSize is the number of stream messages covered by one batch span.
+var batchSize = 50
+
+// metadataCarrier adapts gRPC metadata for the propagators.
+type metadataCarrier metadata.MD
+
+func (c metadataCarrier) Get(key string) string {
+	if v := metadata.MD(c).Get(key); len(v) > 0 {
+		return v[0]
+	}
+	return ""
+}
+
+func (c metadataCarrier) Set(key, value string) {
+	metadata.MD(c).Set(key, value)
+}
+
+func (c metadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for k := range c {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// spanName turns "/calc.Calculator/SquareRoot" into "calc.Calculator/SquareRoot".
+func spanName(fullMethod string) string {
+	return strings.TrimPrefix(fullMethod, "/")
+}
+
+func rpcAttributes(fullMethod string) []attribute.KeyValue {
+	name := spanName(fullMethod)
+	service, method := name, ""
+	if i := strings.Index(name, "/"); i >= 0 {
+		service, method = name[:i], name[i+1:]
+	}
+	return []attribute.KeyValue{
+		attribute.String("rpc.system", "grpc"),
+		attribute.String("rpc.service", service),
+		attribute.String("rpc.method", method),
+	}
+}
+
+func startServerSpan(ctx context.Context, fullMethod string) (context.Context, trace.Span) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	ctx = otel.GetTextMapPropagator().Extract(ctx, metadataCarrier(md.Copy()))
+	return otel.Tracer(instrumentation).Start(ctx, spanName(fullMethod),
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(rpcAttributes(fullMethod)...),
+	)
+}
+
+func startClientSpan(ctx context.Context, fullMethod string) (context.Context, trace.Span) {
+	ctx, span := otel.Tracer(instrumentation).Start(ctx, spanName(fullMethod),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(rpcAttributes(fullMethod)...),
+	)
+	md, ok := metadata.FromOutgoingContext(ctx)
+	if ok {
+		md = md.Copy()
+	} else {
+		md = metadata.MD{}
+	}
+	otel.GetTextMapPropagator().Inject(ctx, metadataCarrier(md))
+	return metadata.NewOutgoingContext(ctx, md), span
+}
+
+// end records the gRPC status of the RPC on the span and ends it.
+func end(span trace.Span, err error) {
+	st := status.Convert(err)
+	span.SetAttributes(attribute.Int("rpc.grpc.status_code", int(st.Code())))
+	if err != nil {
+		span.SetStatus(otelcodes.Error, st.Message())
+	}
+	span.End()
+}
+
+// batcher groups the messages of a stream into child spans of batchSize
+// messages each.
+type batcher struct {
+	ctx  context.Context
+	name string
+
+	mu             sync.Mutex
+	span           trace.Span
+	index          int
+	sent, received int
+}
+
+func (b *batcher) record(sent bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.span == nil {
+		b.index++
+		_, b.span = otel.Tracer(instrumentation).Start(b.ctx, b.name,
+			trace.WithAttributes(attribute.Int("batch.index", b.index)))
+	}
+	if sent {
+		b.sent++
+	} else {
+		b.received++
+	}
+	if b.sent+b.received >= batchSize {
+		b.flushLocked()
+	}
+}
+
+// flush ends the span of the current, possibly partial, batch.
+func (b *batcher) flush() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.flushLocked()
+}
+
+func (b *batcher) flushLocked() {
+	if b.span == nil {
+		return
+	}
+	b.span.SetAttributes(
+		attribute.Int("messages.sent", b.sent),
+		attribute.Int("messages.received", b.received),
+	)
+	b.span.End()
+	b.span = nil
+	b.sent, b.received = 0, 0
+}
+
+// UnaryServerInterceptor traces unary RPCs, continuing the trace of the
+// caller.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, span := startServerSpan(ctx, info.FullMethod)
+		resp, err := handler(ctx, req)
+		end(span, err)
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor traces streaming RPCs with a span for the whole
+// stream and a child span for each batch of messages.
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, span := startServerSpan(stream.Context(), info.FullMethod)
+		b := &batcher{ctx: ctx, name: spanName(info.FullMethod) + " batch"}
+		err := handler(srv, &serverStream{ServerStream: stream, ctx: ctx, batches: b})
+		b.flush()
+		end(span, err)
+		return err
+	}
+}
+
+type serverStream struct {
+	grpc.ServerStream
+	ctx     context.Context
+	batches *batcher
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *serverStream) SendMsg(m interface{}) error {
+	err := s.ServerStream.SendMsg(m)
+	if err == nil {
+		s.batches.record(true)
+	}
+	return err
+}
+
+func (s *serverStream) RecvMsg(m interface{}) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err == nil {
+		s.batches.record(false)
+	}
+	return err
+}
+
+// ServerOptions returns the interceptors tracing the RPCs of a server.
+func ServerOptions() []grpc.ServerOption {
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(UnaryServerInterceptor()),
+		grpc.ChainStreamInterceptor(StreamServerInterceptor()),
+	}
+}
+
+// DialOptions returns the interceptors tracing the RPCs of a client and
+// propagating the trace context to the server.
+func DialOptions() []grpc.DialOption {
+	return []grpc.DialOption{
+		grpc.WithChainUnaryInterceptor(unaryClientInterceptor),
+		grpc.WithChainStreamInterceptor(streamClientInterceptor),
+	}
+}
+
+func unaryClientInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	ctx, span := startClientSpan(ctx, method)
+	err := invoker(ctx, method, req, reply, cc, opts...)
+	end(span, err)
+	return err
+}
+
+func streamClientInterceptor(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	ctx, span := startClientSpan(ctx, method)
+	cs, err := streamer(ctx, desc, cc, method, opts...)
+	if err != nil {
+		end(span, err)
+		return nil, err
+	}
+	return &clientStream{
+		ClientStream:  cs,
+		serverStreams: desc.ServerStreams,
+		span:          span,
+		batches:       &batcher{ctx: ctx, name: spanName(method) + " batch"},
+	}, nil
+}
+
+// clientStream ends the stream span once the server closed the stream.
+type clientStream struct {
+	grpc.ClientStream
+	serverStreams bool
+	span          trace.Span
+	batches       *batcher
+	once          sync.Once
+}
+
+func (s *clientStream) SendMsg(m interface{}) error {
+	err := s.ClientStream.SendMsg(m)
+	if err == nil {
+		s.batches.record(true)
+	}
+	return err
+}
+
+func (s *clientStream) RecvMsg(m interface{}) error {
+	err := s.ClientStream.RecvMsg(m)
+	if err == nil {
+		s.batches.record(false)
+		// Without server streaming the only response closes the stream.
+		if s.serverStreams {
+			return nil
+		}
+	}
+	s.once.Do(func() {
+		s.batches.flush()
+		if err == io.EOF {
+			end(s.span, nil)
+			return
+		}
+		end(s.span, err)
+	})
+	return err
+}
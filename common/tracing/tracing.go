@@ -0,0 +1,144 @@
+// Package tracing sets up OpenTelemetry tracing for the servers and clients
+// and propagates the W3C trace context through the gRPC metadata.
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+)
+
+// Exporters supported by Setup.
+const (
+	ExporterNone   = "none"
+	ExporterStdout = "stdout"
+	ExporterFile   = "file"
+	ExporterOTLP   = "otlp"
+)
+
+// Config selects where the spans are exported to.
+type Config struct {
+	// Exporter is one of none, stdout, file or otlp.
+	Exporter string `yaml:"exporter"`
+	// Endpoint is the host:port of the OTLP gRPC collector.
+	Endpoint string `yaml:"endpoint"`
+	// Insecure disables TLS towards the OTLP collector.
+	Insecure bool `yaml:"insecure"`
+	// File is the path the spans are appended to as JSON by the file
+	// exporter.
+	File string `yaml:"file"`
+	// SampleRatio is the fraction of new traces that are recorded. Traces
+	// started by a caller follow the caller's decision.
+	SampleRatio float64 `yaml:"sample_ratio"`
+	// BatchSize is the number of stream messages covered by one batch span.
+	BatchSize int `yaml:"batch_size"`
+}
+
+// DefaultConfig returns a configuration with tracing disabled.
+func DefaultConfig() Config {
+	return Config{Exporter: ExporterNone, SampleRatio: 1, BatchSize: 50}
+}
+
+// ConfigFromEnv returns the configuration of the clients, read from the
+// OTEL_TRACES_EXPORTER, OTEL_EXPORTER_OTLP_ENDPOINT and OTEL_TRACES_FILE
+// environment variables. The OTLP connection is plaintext.
+func ConfigFromEnv() Config {
+	cfg := DefaultConfig()
+	if v := os.Getenv("OTEL_TRACES_EXPORTER"); v != "" {
+		cfg.Exporter = v
+	}
+	cfg.Endpoint = os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT")
+	cfg.Insecure = true
+	cfg.File = os.Getenv("OTEL_TRACES_FILE")
+	return cfg
+}
+
+// Validate checks the exporter settings.
+func (c *Config) Validate() error {
+	switch c.Exporter {
+	case ExporterNone, ExporterStdout:
+	case ExporterFile:
+		if c.File == "" {
+			return fmt.Errorf("a file is required by the file exporter")
+		}
+	case ExporterOTLP:
+		if c.Endpoint == "" {
+			return fmt.Errorf("an endpoint is required by the otlp exporter")
+		}
+	default:
+		return fmt.Errorf("unknown exporter %q", c.Exporter)
+	}
+	if c.SampleRatio < 0 || c.SampleRatio > 1 {
+		return fmt.Errorf("sample ratio %v is not between 0 and 1", c.SampleRatio)
+	}
+	if c.BatchSize < 1 {
+		return fmt.Errorf("batch size must be positive")
+	}
+	return nil
+}
+
+// Setup installs the global tracer provider exporting the spans of service,
+// and the W3C trace context propagator. The returned function flushes the
+// pending spans and must be called before exiting.
+func Setup(ctx context.Context, cfg Config, service string) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+	batchSize = cfg.BatchSize
+
+	var exporter sdktrace.SpanExporter
+	var closer io.Closer
+	var err error
+	switch cfg.Exporter {
+	case ExporterNone:
+		return func(context.Context) error { return nil }, nil
+	case ExporterStdout:
+		exporter, err = stdouttrace.New(stdouttrace.WithPrettyPrint())
+	case ExporterFile:
+		var f *os.File
+		f, err = os.OpenFile(cfg.File, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+		if err != nil {
+			return nil, fmt.Errorf("opening traces file: %v", err)
+		}
+		closer = f
+		exporter, err = stdouttrace.New(stdouttrace.WithWriter(f))
+	case ExporterOTLP:
+		opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(cfg.Endpoint)}
+		if cfg.Insecure {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		}
+		exporter, err = otlptracegrpc.New(ctx, opts...)
+	default:
+		return nil, fmt.Errorf("unknown exporter %q", cfg.Exporter)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("creating %s exporter: %v", cfg.Exporter, err)
+	}
+
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceNameKey.String(service))),
+	)
+	otel.SetTracerProvider(tp)
+	log.Infof("Exporting traces of %s to %s", service, cfg.Exporter)
+
+	return func(ctx context.Context) error {
+		err := tp.Shutdown(ctx)
+		if closer != nil {
+			closer.Close()
+		}
+		return err
+	}, nil
+}
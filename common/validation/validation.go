@@ -0,0 +1,149 @@
+// Package validation enforces the rules declared on the request messages in
+// the protos, through the Validate methods generated by protoc-gen-validate,
+// before the handlers run.
+package validation
+
+import (
+	"context"
+	"strings"
+	"unicode"
+
+	"grpc-course/common/rpcerr"
+
+	"google.golang.org/grpc"
+)
+
+// Reason is the ErrorInfo reason of the requests breaking their rules.
+const Reason = "INVALID_REQUEST"
+
+// validator is implemented by the messages generated by protoc-gen-validate.
+type validator interface {
+	ValidateAll() error
+}
+
+// fieldError is implemented by the errors of a single field.
+type fieldError interface {
+	Field() string
+	Reason() string
+	Cause() error
+}
+
+// multiError is implemented by the errors listing every violation of a
+// message.
+type multiError interface {
+	AllErrors() []error
+}
+
+// Validator checks the requests of a service against their rules.
+type Validator struct {
+	// Domain is the ErrorInfo domain of the service.
+	Domain string
+}
+
+// Check returns nil if msg is valid or has no rules, and otherwise the
+// INVALID_ARGUMENT error listing every violated field.
+func (v *Validator) Check(msg interface{}) error {
+	m, ok := msg.(validator)
+	if !ok {
+		return nil
+	}
+	err := m.ValidateAll()
+	if err == nil {
+		return nil
+	}
+	violations := fieldViolations("", err)
+	var fields []string
+	for i, fv := range violations {
+		if i == 0 || fv.Field != violations[i-1].Field {
+			fields = append(fields, fv.Field)
+		}
+	}
+	return rpcerr.InvalidArgument(v.Domain, Reason, "Invalid "+strings.Join(fields, ", "), violations...)
+}
+
+// fieldViolations flattens err, of the field at path, to the violations of
+// the innermost fields.
+func fieldViolations(path string, err error) []rpcerr.FieldViolation {
+	if m, ok := err.(multiError); ok {
+		var violations []rpcerr.FieldViolation
+		for _, e := range m.AllErrors() {
+			violations = append(violations, fieldViolations(path, e)...)
+		}
+		return violations
+	}
+	f, ok := err.(fieldError)
+	if !ok {
+		return []rpcerr.FieldViolation{rpcerr.Violation(path, err.Error())}
+	}
+	field := fieldName(f.Field())
+	if path != "" {
+		field = path + "." + field
+	}
+	if f.Cause() != nil {
+		if _, nested := f.Cause().(multiError); nested {
+			return fieldViolations(field, f.Cause())
+		}
+		if _, nested := f.Cause().(fieldError); nested {
+			return fieldViolations(field, f.Cause())
+		}
+	}
+	return []rpcerr.FieldViolation{rpcerr.Violation(field, f.Reason())}
+}
+
+// fieldName turns the Go name of a field, e.g. "FirstName" or
+// "Operations[3]", into its proto name, "first_name" or "operations[3]".
+func fieldName(goName string) string {
+	name, index := goName, ""
+	if i := strings.IndexByte(goName, '['); i >= 0 {
+		name, index = goName[:i], goName[i:]
+	}
+	var b strings.Builder
+	for i, r := range name {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String() + index
+}
+
+// UnaryServerInterceptor rejects the invalid requests of unary RPCs.
+func (v *Validator) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := v.Check(req); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor rejects the invalid messages of streaming RPCs as
+// the handlers receive them.
+func (v *Validator) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &validatingStream{ServerStream: stream, v: v})
+	}
+}
+
+type validatingStream struct {
+	grpc.ServerStream
+	v *Validator
+}
+
+func (s *validatingStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return s.v.Check(m)
+}
+
+// ServerOptions returns the validation interceptors.
+func (v *Validator) ServerOptions() []grpc.ServerOption {
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(v.UnaryServerInterceptor()),
+		grpc.ChainStreamInterceptor(v.StreamServerInterceptor()),
+	}
+}
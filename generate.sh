@@ -3,12 +3,18 @@
 protoc -I/usr/local/include -I. \
   -I$GOPATH/src \
   -I$GOPATH/src/github.com/grpc-ecosystem/grpc-gateway/third_party/googleapis \
-  --go_out=plugins=grpc:. ./calc/calc_proto/calc.proto
+  -I$GOPATH/src/github.com/envoyproxy/protoc-gen-validate \
+  --go_out=plugins=grpc:. \
+  --grpc-gateway_out=logtostderr=true:. \
+  --validate_out=lang=go,paths=source_relative:. ./calc/calc_proto/calc.proto
 
 protoc -I/usr/local/include -I. \
   -I$GOPATH/src \
   -I$GOPATH/src/github.com/grpc-ecosystem/grpc-gateway/third_party/googleapis \
-  --go_out=plugins=grpc:. ./greet/greet_pb/greet.proto
+  -I$GOPATH/src/github.com/envoyproxy/protoc-gen-validate \
+  --go_out=plugins=grpc:. \
+  --grpc-gateway_out=logtostderr=true:. \
+  --validate_out=lang=go,paths=source_relative:. ./greet/greet_pb/greet.proto
 
 # protoc -I ./calc/calc_proto/ ./calc/calc_proto/calc.proto --go_out=plugins=grpc:./calc/calc_proto/.
 # protoc -I ./greet/greet_pb/ ./greet/greet_pb/greet.proto --go_out=plugins=grpc:./greet/greet_pb/.
\ No newline at end of file
@@ -2,6 +2,10 @@ package main
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"io/ioutil"
 	"strings"
 	"fmt"
 	"io"
@@ -10,6 +14,9 @@ import (
 	"reflect"
 	"runtime"
 
+	"grpc-course/common/auth"
+	"grpc-course/common/rpcerr"
+	"grpc-course/common/tracing"
 	greetpb "grpc-course/greet/greet_pb"
 
 	log "github.com/sirupsen/logrus"
@@ -17,7 +24,6 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
-	"google.golang.org/grpc/status"
 )
 
 func init() {
@@ -28,20 +34,39 @@ func init() {
 func main() {
 	log.Infof("Setting up client...")
 
-	tls := false
-	opts := grpc.WithInsecure()
-	if tls {
+	shutdownTracing, err := tracing.Setup(context.Background(), tracing.ConfigFromEnv(), "greet_client")
+	if err != nil {
+		log.Fatalf("Error setting up tracing: %v", err)
+	}
+	defer shutdownTracing(context.Background())
+
+	useTLS := false
+	mutualTLS := false
+	opts := []grpc.DialOption{grpc.WithInsecure()}
+	if useTLS {
 		certFile := "ssl/ca.crt" // Certificate authority trust certificate
 		creds, sslError := credentials.NewClientTLSFromFile(certFile, "")
 		if sslError != nil {
 			log.Fatalf("Fail while loading ca trust certificates: %v", sslError)
 			return
 		}
+		if mutualTLS {
+			// The client certificate identifies us to the server
+			creds, sslError = loadMutualTLS(certFile, "ssl/client.crt", "ssl/client.pem")
+			if sslError != nil {
+				log.Fatalf("Fail while loading client certificates: %v", sslError)
+				return
+			}
+		}
 
-		opts = grpc.WithTransportCredentials(creds)
+		opts = []grpc.DialOption{grpc.WithTransportCredentials(creds)}
+	}
+	opts = append(opts, tracing.DialOptions()...)
+	if token := os.Getenv("GREET_TOKEN"); token != "" {
+		opts = append(opts, grpc.WithPerRPCCredentials(&auth.TokenCredentials{Token: token, Insecure: !useTLS}))
 	}
 
-	cc, err := grpc.Dial("localhost:50051", opts)
+	cc, err := grpc.Dial("localhost:50051", opts...)
 	if err != nil {
 		log.Fatalf("Error dialing: %s", err)
 	}
@@ -102,6 +127,25 @@ func main() {
 	// doUnaryWithDeadline(c, time.Second*5)
 }
 
+func loadMutualTLS(caFile, certFile, keyFile string) (credentials.TransportCredentials, error) {
+	ca, err := ioutil.ReadFile(caFile)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(ca) {
+		return nil, fmt.Errorf("no certificates found in %s", caFile)
+	}
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, err
+	}
+	return credentials.NewTLS(&tls.Config{
+		RootCAs:      pool,
+		Certificates: []tls.Certificate{cert},
+	}), nil
+}
+
 func nameOf(f interface{}) string {
 	v := reflect.ValueOf(f)
 	if v.Kind() == reflect.Func {
@@ -126,12 +170,12 @@ func doUnaryWithDeadline(c greetpb.GreetServiceClient, timeout time.Duration) {
 	defer cancel()
 	resp, err := c.GreetWithDeadline(ctx, req)
 	if err != nil {
-		statusErr, ok := status.FromError(err)
-		if ok {
-			if statusErr.Code() == codes.DeadlineExceeded {
+		var rpcErr *rpcerr.Error
+		if errors.As(rpcerr.Decode(err), &rpcErr) {
+			if rpcErr.Code == codes.DeadlineExceeded {
 				fmt.Printf("Exceeded deadline for a call to greet with deadline with %v sec\n", timeout.Seconds())
 			} else {
-				fmt.Printf("Unexpected grpc error: %v\n", statusErr)
+				fmt.Printf("Unexpected grpc error: %v (reason %q)\n", rpcErr, rpcErr.Reason)
 			}
 			return
 		}
@@ -6,6 +6,7 @@ package greetpb
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
 	proto "github.com/golang/protobuf/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
@@ -25,6 +26,8 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+// Names are letters, spaces, apostrophes, hyphens and periods, starting with
+// a letter.
 type Greeting struct {
 	FirstName            string   `protobuf:"bytes,1,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
 	LastName             string   `protobuf:"bytes,2,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
@@ -479,34 +482,39 @@ func init() {
 func init() { proto.RegisterFile("greet/greet_pb/greet.proto", fileDescriptor_cf6b991480bff0a2) }
 
 var fileDescriptor_cf6b991480bff0a2 = []byte{
-	// 423 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xc1, 0x6e, 0xda, 0x40,
-	0x10, 0xad, 0xa9, 0xa0, 0x78, 0xda, 0xd2, 0xb2, 0xa5, 0x94, 0x1a, 0x50, 0x91, 0x2f, 0x45, 0x45,
-	0xc2, 0x14, 0x6e, 0xed, 0xa1, 0x12, 0xa5, 0x25, 0x87, 0x24, 0x4a, 0x48, 0xa4, 0x44, 0xb9, 0xa0,
-	0x25, 0xd9, 0x38, 0x2b, 0x99, 0x5d, 0xc7, 0x5e, 0x90, 0xb8, 0xe6, 0x17, 0x72, 0xcc, 0x67, 0xe5,
-	0x17, 0xf2, 0x21, 0x11, 0xeb, 0x35, 0x18, 0x03, 0x41, 0xf2, 0xc5, 0xf2, 0xcc, 0x7b, 0xf3, 0xde,
-	0x78, 0x67, 0xbc, 0x60, 0xd8, 0x1e, 0x21, 0xc2, 0x92, 0xcf, 0xa1, 0x3b, 0x0a, 0x5e, 0x9a, 0xae,
-	0xc7, 0x05, 0x47, 0x69, 0x19, 0x18, 0x15, 0x9b, 0x73, 0xdb, 0x21, 0x16, 0x76, 0xa9, 0x85, 0x19,
-	0xe3, 0x02, 0x0b, 0xca, 0x99, 0x1f, 0x90, 0xcc, 0xff, 0x90, 0xed, 0xcf, 0x69, 0x94, 0xd9, 0xa8,
-	0x0a, 0x70, 0x4d, 0x3d, 0x5f, 0x0c, 0x19, 0x1e, 0x93, 0x92, 0x56, 0xd3, 0xea, 0xfa, 0x40, 0x97,
-	0x99, 0x43, 0x3c, 0x26, 0xa8, 0x0c, 0xba, 0x83, 0x43, 0x34, 0x25, 0xd1, 0xec, 0x3c, 0x31, 0x07,
-	0xcd, 0xdf, 0xf0, 0x4e, 0xea, 0x0c, 0xc8, 0xed, 0x84, 0xf8, 0x02, 0x35, 0x20, 0x6b, 0x2b, 0x5d,
-	0xa9, 0xf4, 0xb6, 0xfd, 0xa1, 0x19, 0x34, 0x17, 0xda, 0x0d, 0x16, 0x04, 0xf3, 0x3b, 0xbc, 0x57,
-	0xc5, 0xbe, 0xcb, 0x99, 0x4f, 0x50, 0x11, 0x32, 0x1e, 0xf1, 0x27, 0x8e, 0x50, 0x5d, 0xa8, 0xc8,
-	0xec, 0xc1, 0x67, 0x49, 0x3c, 0xc0, 0x6c, 0x76, 0x4a, 0xc7, 0xc4, 0x4f, 0x64, 0xd7, 0x82, 0x62,
-	0x5c, 0x65, 0x87, 0xef, 0x1f, 0xf8, 0xb8, 0xcf, 0x99, 0x9d, 0xfc, 0x0b, 0x1b, 0x90, 0x8f, 0x08,
-	0xec, 0x70, 0xfb, 0x0b, 0x05, 0x49, 0xfc, 0x37, 0x25, 0xde, 0x8c, 0x33, 0x92, 0xc8, 0xd1, 0x52,
-	0x47, 0xb5, 0x14, 0xd9, 0xe1, 0xda, 0x87, 0x92, 0x2c, 0x38, 0xa3, 0xe2, 0xa6, 0x47, 0xf0, 0x95,
-	0x43, 0x13, 0x3a, 0x77, 0xe0, 0xeb, 0x06, 0xa1, 0x97, 0xdd, 0xdb, 0x0f, 0xaf, 0xd5, 0x02, 0x9d,
-	0x10, 0x6f, 0x4a, 0x2f, 0x09, 0xda, 0x83, 0xb4, 0x8c, 0xd1, 0xa7, 0xa8, 0x93, 0x6a, 0xc8, 0x28,
-	0xac, 0x26, 0x03, 0x71, 0xb3, 0x70, 0xf7, 0xf8, 0x74, 0x9f, 0xca, 0x99, 0xba, 0x35, 0xfd, 0x19,
-	0xfc, 0x0a, 0xbf, 0xb4, 0x1f, 0xe8, 0x18, 0x72, 0xab, 0xe3, 0x46, 0x95, 0x68, 0x75, 0x7c, 0x97,
-	0x8c, 0xea, 0x16, 0x54, 0x99, 0xbc, 0x6a, 0x69, 0xa8, 0x0b, 0xfa, 0x62, 0x9c, 0xe8, 0x8b, 0xe2,
-	0xc7, 0x37, 0xc4, 0x28, 0xad, 0x03, 0xa1, 0x46, 0x5d, 0x43, 0x47, 0x6a, 0xe9, 0xc3, 0x01, 0xa1,
-	0x72, 0xd4, 0x37, 0x36, 0x7b, 0xa3, 0xb2, 0x19, 0x5c, 0xea, 0xb5, 0x34, 0x74, 0x0e, 0xf9, 0xb5,
-	0x83, 0x47, 0xdf, 0xa2, 0x85, 0x1b, 0x66, 0x6b, 0xd4, 0xb6, 0x13, 0x42, 0xf5, 0xae, 0x7e, 0xf1,
-	0x46, 0x92, 0xdc, 0xd1, 0x28, 0x23, 0xef, 0x8d, 0xce, 0x73, 0x00, 0x00, 0x00, 0xff, 0xff, 0x39,
-	0xba, 0x40, 0xd4, 0x7a, 0x04, 0x00, 0x00,
+	// 500 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0xc7, 0x71, 0x51, 0x47, 0xf3, 0x60, 0xa3, 0x33, 0xdd, 0x16, 0xb2, 0xc2, 0x4a, 0xa8, 0xb4,
+	0x32, 0x44, 0x53, 0x3a, 0x71, 0xe1, 0xc2, 0x88, 0x98, 0x00, 0x69, 0x9d, 0x58, 0x40, 0x02, 0xc1,
+	0xd8, 0xe4, 0x32, 0x13, 0x2c, 0xa5, 0x76, 0x48, 0xbc, 0x4a, 0xd3, 0xb4, 0x0b, 0x47, 0x6e, 0x88,
+	0x23, 0x1f, 0x8b, 0xaf, 0xc0, 0xa7, 0xe8, 0x09, 0x61, 0x27, 0x23, 0xcd, 0x32, 0x7a, 0x28, 0x97,
+	0x28, 0xee, 0xfb, 0xbf, 0xdf, 0xff, 0x6f, 0xfb, 0x35, 0x60, 0xf9, 0x11, 0xa5, 0xd2, 0x51, 0xcf,
+	0xfd, 0xb0, 0xaf, 0x5f, 0xda, 0x61, 0x24, 0xa4, 0xc0, 0x65, 0xb5, 0xb0, 0xea, 0xbe, 0x10, 0x7e,
+	0x40, 0x1d, 0x12, 0x32, 0x87, 0x70, 0x2e, 0x24, 0x91, 0x4c, 0xf0, 0x58, 0x8b, 0xac, 0xa5, 0x21,
+	0x09, 0xd8, 0x01, 0x91, 0xd4, 0x49, 0x5f, 0x74, 0xc1, 0xfe, 0x86, 0xa0, 0xf2, 0xf4, 0x0f, 0x80,
+	0x71, 0x1f, 0xbb, 0x00, 0x1f, 0x59, 0x14, 0xcb, 0x7d, 0x4e, 0x06, 0xd4, 0x44, 0x0d, 0xd4, 0x32,
+	0xdc, 0xdb, 0x23, 0xb7, 0x11, 0xdd, 0xac, 0x22, 0x73, 0xa3, 0x6b, 0xee, 0xed, 0x86, 0xc7, 0x5b,
+	0x27, 0xef, 0xd4, 0x73, 0x37, 0x3c, 0xee, 0x9d, 0xac, 0x36, 0xda, 0xf7, 0xde, 0xaf, 0x35, 0x3d,
+	0x43, 0xb5, 0x6d, 0x93, 0x01, 0xc5, 0x8f, 0xc1, 0x08, 0x48, 0x8a, 0x28, 0x29, 0x44, 0x73, 0xe4,
+	0xde, 0x8a, 0x56, 0xcc, 0x8d, 0xee, 0xf2, 0x5e, 0xab, 0x98, 0x70, 0xe7, 0x51, 0xd3, 0xab, 0x04,
+	0x44, 0x23, 0xec, 0x4d, 0xb8, 0xa2, 0x22, 0x79, 0xf4, 0xf3, 0x21, 0x8d, 0x25, 0x7e, 0x00, 0x15,
+	0x3f, 0x89, 0xa8, 0x42, 0x5d, 0xee, 0x5e, 0x6d, 0xeb, 0x13, 0x48, 0x93, 0xbb, 0x95, 0x91, 0x5b,
+	0xfe, 0x8a, 0x4a, 0x55, 0xe4, 0x9d, 0x4a, 0xed, 0x55, 0x98, 0x4d, 0x30, 0x71, 0x28, 0x78, 0x4c,
+	0xf1, 0x22, 0xcc, 0x44, 0x34, 0x3e, 0x0c, 0xa4, 0xde, 0x9a, 0x97, 0xac, 0xec, 0x6d, 0x58, 0x50,
+	0xc2, 0x1e, 0xe1, 0x47, 0xaf, 0xd8, 0x80, 0xc6, 0x53, 0x1a, 0x77, 0x60, 0x31, 0xcf, 0x9b, 0x90,
+	0xe0, 0x39, 0x54, 0xb7, 0x04, 0xf7, 0xff, 0xc7, 0xae, 0xef, 0xc2, 0x7c, 0x06, 0x35, 0xc1, 0xb7,
+	0x07, 0x35, 0x25, 0xdc, 0x1c, 0xd2, 0xe8, 0x48, 0x70, 0x3a, 0xa5, 0xb7, 0x03, 0x0b, 0x39, 0xdc,
+	0x04, 0xff, 0x1d, 0x30, 0x55, 0xc3, 0x6b, 0x26, 0x3f, 0x3d, 0xa1, 0xe4, 0x20, 0x60, 0x53, 0x67,
+	0x58, 0x87, 0xeb, 0x05, 0xc8, 0x7f, 0xe7, 0xe8, 0xfe, 0xb8, 0x98, 0x8c, 0xdc, 0x4b, 0x1a, 0x0d,
+	0xd9, 0x07, 0x8a, 0x9f, 0x41, 0x59, 0xad, 0xf1, 0xb5, 0xac, 0x67, 0x12, 0xcd, 0xaa, 0x8d, 0xff,
+	0xa8, 0xe1, 0x76, 0xed, 0xcb, 0xcf, 0x5f, 0xdf, 0x4b, 0x73, 0xb6, 0xe1, 0x0c, 0xef, 0xeb, 0x7f,
+	0xe8, 0x43, 0xb4, 0x86, 0x77, 0x60, 0x6e, 0x7c, 0x18, 0x70, 0x3d, 0xdb, 0x9d, 0x9f, 0x39, 0xeb,
+	0xc6, 0x39, 0xd5, 0xc4, 0xe4, 0x42, 0x07, 0x61, 0x17, 0x8c, 0xd3, 0x2b, 0xc6, 0x4b, 0x89, 0x3e,
+	0x3f, 0x3f, 0x96, 0x79, 0xb6, 0x90, 0x32, 0x5a, 0x08, 0xbf, 0x80, 0xd9, 0xb1, 0xab, 0xc2, 0xcb,
+	0x59, 0xdf, 0xdc, 0x3c, 0x58, 0xf5, 0xe2, 0xe2, 0x5f, 0x5e, 0x07, 0xe1, 0x37, 0x30, 0x7f, 0xe6,
+	0xe0, 0xf1, 0x4a, 0xb6, 0xb1, 0xe0, 0x96, 0xad, 0xc6, 0xf9, 0x82, 0x94, 0xee, 0x1a, 0x6f, 0x2f,
+	0x29, 0x51, 0xd8, 0xef, 0xcf, 0xa8, 0xaf, 0xd6, 0xfa, 0xef, 0x01, 0x00, 0xca, 0x11, 0x2e, 0x39,
+	0x11, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -0,0 +1,1406 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: greet/greet_pb/greet.proto
+
+package greetpb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on Greeting with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Greeting) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Greeting with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GreetingMultiError, or nil
+// if none found.
+func (m *Greeting) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Greeting) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetFirstName()); l < 1 || l > 64 {
+		err := GreetingValidationError{
+			field:  "FirstName",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_Greeting_FirstName_Pattern.MatchString(m.GetFirstName()) {
+		err := GreetingValidationError{
+			field:  "FirstName",
+			reason: "value does not match regex pattern \"^\\\\p{L}[\\\\p{L}\\\\p{M}' .-]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetLastName()) > 64 {
+		err := GreetingValidationError{
+			field:  "LastName",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_Greeting_LastName_Pattern.MatchString(m.GetLastName()) {
+		err := GreetingValidationError{
+			field:  "LastName",
+			reason: "value does not match regex pattern \"^(\\\\p{L}[\\\\p{L}\\\\p{M}' .-]*)?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GreetingMultiError(errors)
+	}
+
+	return nil
+}
+
+// GreetingMultiError is an error wrapping multiple validation errors returned
+// by Greeting.ValidateAll() if the designated constraints aren't met.
+type GreetingMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GreetingMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GreetingMultiError) AllErrors() []error { return m }
+
+// GreetingValidationError is the validation error returned by
+// Greeting.Validate if the designated constraints aren't met.
+type GreetingValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GreetingValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GreetingValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GreetingValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GreetingValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GreetingValidationError) ErrorName() string { return "GreetingValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GreetingValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGreeting.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GreetingValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GreetingValidationError{}
+
+var _Greeting_FirstName_Pattern = regexp.MustCompile("^\\p{L}[\\p{L}\\p{M}' .-]*$")
+
+var _Greeting_LastName_Pattern = regexp.MustCompile("^(\\p{L}[\\p{L}\\p{M}' .-]*)?$")
+
+// Validate checks the field values on GreetRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GreetRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GreetRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GreetRequestMultiError, or
+// nil if none found.
+func (m *GreetRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GreetRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetGreeting() == nil {
+		err := GreetRequestValidationError{
+			field:  "Greeting",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetGreeting()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GreetRequestValidationError{
+					field:  "Greeting",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GreetRequestValidationError{
+					field:  "Greeting",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGreeting()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GreetRequestValidationError{
+				field:  "Greeting",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GreetRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GreetRequestMultiError is an error wrapping multiple validation errors
+// returned by GreetRequest.ValidateAll() if the designated constraints aren't met.
+type GreetRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GreetRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GreetRequestMultiError) AllErrors() []error { return m }
+
+// GreetRequestValidationError is the validation error returned by
+// GreetRequest.Validate if the designated constraints aren't met.
+type GreetRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GreetRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GreetRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GreetRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GreetRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GreetRequestValidationError) ErrorName() string { return "GreetRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GreetRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGreetRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GreetRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GreetRequestValidationError{}
+
+// Validate checks the field values on GreetResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GreetResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GreetResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GreetResponseMultiError, or
+// nil if none found.
+func (m *GreetResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GreetResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Result
+
+	if len(errors) > 0 {
+		return GreetResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GreetResponseMultiError is an error wrapping multiple validation errors
+// returned by GreetResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GreetResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GreetResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GreetResponseMultiError) AllErrors() []error { return m }
+
+// GreetResponseValidationError is the validation error returned by
+// GreetResponse.Validate if the designated constraints aren't met.
+type GreetResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GreetResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GreetResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GreetResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GreetResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GreetResponseValidationError) ErrorName() string { return "GreetResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GreetResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGreetResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GreetResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GreetResponseValidationError{}
+
+// Validate checks the field values on GreetManyTimesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GreetManyTimesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GreetManyTimesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GreetManyTimesRequestMultiError, or nil if none found.
+func (m *GreetManyTimesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GreetManyTimesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetGreeting() == nil {
+		err := GreetManyTimesRequestValidationError{
+			field:  "Greeting",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetGreeting()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GreetManyTimesRequestValidationError{
+					field:  "Greeting",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GreetManyTimesRequestValidationError{
+					field:  "Greeting",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGreeting()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GreetManyTimesRequestValidationError{
+				field:  "Greeting",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GreetManyTimesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GreetManyTimesRequestMultiError is an error wrapping multiple validation
+// errors returned by GreetManyTimesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GreetManyTimesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GreetManyTimesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GreetManyTimesRequestMultiError) AllErrors() []error { return m }
+
+// GreetManyTimesRequestValidationError is the validation error returned by
+// GreetManyTimesRequest.Validate if the designated constraints aren't met.
+type GreetManyTimesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GreetManyTimesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GreetManyTimesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GreetManyTimesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GreetManyTimesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GreetManyTimesRequestValidationError) ErrorName() string {
+	return "GreetManyTimesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GreetManyTimesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGreetManyTimesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GreetManyTimesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GreetManyTimesRequestValidationError{}
+
+// Validate checks the field values on GreetManyTimesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GreetManyTimesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GreetManyTimesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GreetManyTimesResponseMultiError, or nil if none found.
+func (m *GreetManyTimesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GreetManyTimesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Result
+
+	if len(errors) > 0 {
+		return GreetManyTimesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GreetManyTimesResponseMultiError is an error wrapping multiple validation
+// errors returned by GreetManyTimesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GreetManyTimesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GreetManyTimesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GreetManyTimesResponseMultiError) AllErrors() []error { return m }
+
+// GreetManyTimesResponseValidationError is the validation error returned by
+// GreetManyTimesResponse.Validate if the designated constraints aren't met.
+type GreetManyTimesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GreetManyTimesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GreetManyTimesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GreetManyTimesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GreetManyTimesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GreetManyTimesResponseValidationError) ErrorName() string {
+	return "GreetManyTimesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GreetManyTimesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGreetManyTimesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GreetManyTimesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GreetManyTimesResponseValidationError{}
+
+// Validate checks the field values on LongGreetRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *LongGreetRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LongGreetRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LongGreetRequestMultiError, or nil if none found.
+func (m *LongGreetRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LongGreetRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetGreeting() == nil {
+		err := LongGreetRequestValidationError{
+			field:  "Greeting",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetGreeting()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, LongGreetRequestValidationError{
+					field:  "Greeting",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, LongGreetRequestValidationError{
+					field:  "Greeting",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGreeting()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LongGreetRequestValidationError{
+				field:  "Greeting",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return LongGreetRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// LongGreetRequestMultiError is an error wrapping multiple validation errors
+// returned by LongGreetRequest.ValidateAll() if the designated constraints
+// aren't met.
+type LongGreetRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LongGreetRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LongGreetRequestMultiError) AllErrors() []error { return m }
+
+// LongGreetRequestValidationError is the validation error returned by
+// LongGreetRequest.Validate if the designated constraints aren't met.
+type LongGreetRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LongGreetRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LongGreetRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LongGreetRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LongGreetRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LongGreetRequestValidationError) ErrorName() string { return "LongGreetRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LongGreetRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLongGreetRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LongGreetRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LongGreetRequestValidationError{}
+
+// Validate checks the field values on LongGreetResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *LongGreetResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LongGreetResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LongGreetResponseMultiError, or nil if none found.
+func (m *LongGreetResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LongGreetResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Result
+
+	if len(errors) > 0 {
+		return LongGreetResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// LongGreetResponseMultiError is an error wrapping multiple validation errors
+// returned by LongGreetResponse.ValidateAll() if the designated constraints
+// aren't met.
+type LongGreetResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LongGreetResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LongGreetResponseMultiError) AllErrors() []error { return m }
+
+// LongGreetResponseValidationError is the validation error returned by
+// LongGreetResponse.Validate if the designated constraints aren't met.
+type LongGreetResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LongGreetResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LongGreetResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LongGreetResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LongGreetResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LongGreetResponseValidationError) ErrorName() string {
+	return "LongGreetResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e LongGreetResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLongGreetResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LongGreetResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LongGreetResponseValidationError{}
+
+// Validate checks the field values on GreetEveryoneRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GreetEveryoneRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GreetEveryoneRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GreetEveryoneRequestMultiError, or nil if none found.
+func (m *GreetEveryoneRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GreetEveryoneRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetGreeting() == nil {
+		err := GreetEveryoneRequestValidationError{
+			field:  "Greeting",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetGreeting()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GreetEveryoneRequestValidationError{
+					field:  "Greeting",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GreetEveryoneRequestValidationError{
+					field:  "Greeting",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGreeting()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GreetEveryoneRequestValidationError{
+				field:  "Greeting",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GreetEveryoneRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GreetEveryoneRequestMultiError is an error wrapping multiple validation
+// errors returned by GreetEveryoneRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GreetEveryoneRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GreetEveryoneRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GreetEveryoneRequestMultiError) AllErrors() []error { return m }
+
+// GreetEveryoneRequestValidationError is the validation error returned by
+// GreetEveryoneRequest.Validate if the designated constraints aren't met.
+type GreetEveryoneRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GreetEveryoneRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GreetEveryoneRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GreetEveryoneRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GreetEveryoneRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GreetEveryoneRequestValidationError) ErrorName() string {
+	return "GreetEveryoneRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GreetEveryoneRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGreetEveryoneRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GreetEveryoneRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GreetEveryoneRequestValidationError{}
+
+// Validate checks the field values on GreetEveryoneResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GreetEveryoneResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GreetEveryoneResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GreetEveryoneResponseMultiError, or nil if none found.
+func (m *GreetEveryoneResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GreetEveryoneResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Result
+
+	if len(errors) > 0 {
+		return GreetEveryoneResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GreetEveryoneResponseMultiError is an error wrapping multiple validation
+// errors returned by GreetEveryoneResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GreetEveryoneResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GreetEveryoneResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GreetEveryoneResponseMultiError) AllErrors() []error { return m }
+
+// GreetEveryoneResponseValidationError is the validation error returned by
+// GreetEveryoneResponse.Validate if the designated constraints aren't met.
+type GreetEveryoneResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GreetEveryoneResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GreetEveryoneResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GreetEveryoneResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GreetEveryoneResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GreetEveryoneResponseValidationError) ErrorName() string {
+	return "GreetEveryoneResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GreetEveryoneResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGreetEveryoneResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GreetEveryoneResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GreetEveryoneResponseValidationError{}
+
+// Validate checks the field values on GreetWithDeadlineRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GreetWithDeadlineRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GreetWithDeadlineRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GreetWithDeadlineRequestMultiError, or nil if none found.
+func (m *GreetWithDeadlineRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GreetWithDeadlineRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetGreeting() == nil {
+		err := GreetWithDeadlineRequestValidationError{
+			field:  "Greeting",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetGreeting()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GreetWithDeadlineRequestValidationError{
+					field:  "Greeting",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GreetWithDeadlineRequestValidationError{
+					field:  "Greeting",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGreeting()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GreetWithDeadlineRequestValidationError{
+				field:  "Greeting",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GreetWithDeadlineRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GreetWithDeadlineRequestMultiError is an error wrapping multiple validation
+// errors returned by GreetWithDeadlineRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GreetWithDeadlineRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GreetWithDeadlineRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GreetWithDeadlineRequestMultiError) AllErrors() []error { return m }
+
+// GreetWithDeadlineRequestValidationError is the validation error returned by
+// GreetWithDeadlineRequest.Validate if the designated constraints aren't met.
+type GreetWithDeadlineRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GreetWithDeadlineRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GreetWithDeadlineRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GreetWithDeadlineRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GreetWithDeadlineRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GreetWithDeadlineRequestValidationError) ErrorName() string {
+	return "GreetWithDeadlineRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GreetWithDeadlineRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGreetWithDeadlineRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GreetWithDeadlineRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GreetWithDeadlineRequestValidationError{}
+
+// Validate checks the field values on GreetWithDeadlineResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GreetWithDeadlineResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GreetWithDeadlineResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GreetWithDeadlineResponseMultiError, or nil if none found.
+func (m *GreetWithDeadlineResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GreetWithDeadlineResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Result
+
+	if len(errors) > 0 {
+		return GreetWithDeadlineResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GreetWithDeadlineResponseMultiError is an error wrapping multiple validation
+// errors returned by GreetWithDeadlineResponse.ValidateAll() if the
+// designated constraints aren't met.
+type GreetWithDeadlineResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GreetWithDeadlineResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GreetWithDeadlineResponseMultiError) AllErrors() []error { return m }
+
+// GreetWithDeadlineResponseValidationError is the validation error returned by
+// GreetWithDeadlineResponse.Validate if the designated constraints aren't met.
+type GreetWithDeadlineResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GreetWithDeadlineResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GreetWithDeadlineResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GreetWithDeadlineResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GreetWithDeadlineResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GreetWithDeadlineResponseValidationError) ErrorName() string {
+	return "GreetWithDeadlineResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GreetWithDeadlineResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGreetWithDeadlineResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GreetWithDeadlineResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GreetWithDeadlineResponseValidationError{}
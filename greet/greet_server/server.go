@@ -9,12 +9,23 @@ import (
 	"strconv"
 	"time"
 
+	"grpc-course/common/config"
+	"grpc-course/common/gateway"
+	"grpc-course/common/logging"
+	"grpc-course/common/metrics"
+	"grpc-course/common/readiness"
+	"grpc-course/common/recovery"
+	"grpc-course/common/rpcerr"
+	"grpc-course/common/shutdown"
+	"grpc-course/common/tracing"
+	"grpc-course/common/validation"
 	greetpb "grpc-course/greet/greet_pb"
 
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/status"
 )
 
@@ -25,8 +36,11 @@ func init() {
 
 type server struct{}
 
+// validator checks the requests against the rules of greet.proto, so the
+// handlers can rely on them.
+var validator = &validation.Validator{Domain: "greet.grpc-course"}
+
 func (*server) GreetWithDeadline(ctx context.Context, req *greetpb.GreetWithDeadlineRequest) (*greetpb.GreetWithDeadlineResponse, error) {
-	log.Infof("Processing unary request: %v", req)
 	for i := 0; i < 3; i++ {
 		if ctx.Err() == context.Canceled {
 			return nil, status.Error(
@@ -36,8 +50,8 @@ func (*server) GreetWithDeadline(ctx context.Context, req *greetpb.GreetWithDead
 		}
 		time.Sleep(time.Second)
 	}
-	firstName := req.Greeting.FirstName
-	lastName := req.Greeting.LastName
+	firstName := req.GetGreeting().GetFirstName()
+	lastName := req.GetGreeting().GetLastName()
 	result := "Hello " + firstName + " " + lastName
 
 	return &greetpb.GreetWithDeadlineResponse{
@@ -46,10 +60,8 @@ func (*server) GreetWithDeadline(ctx context.Context, req *greetpb.GreetWithDead
 }
 
 func (*server) Greet(ctx context.Context, req *greetpb.GreetRequest) (*greetpb.GreetResponse, error) {
-	log.Infof("Processing unary request: %v", req)
-
-	firstName := req.Greeting.FirstName
-	lastName := req.Greeting.LastName
+	firstName := req.GetGreeting().GetFirstName()
+	lastName := req.GetGreeting().GetLastName()
 	result := "Hello " + firstName + " " + lastName
 
 	return &greetpb.GreetResponse{
@@ -58,10 +70,8 @@ func (*server) Greet(ctx context.Context, req *greetpb.GreetRequest) (*greetpb.G
 }
 
 func (*server) GreetManyTimes(req *greetpb.GreetManyTimesRequest, stream greetpb.GreetService_GreetManyTimesServer) error {
-	log.Infof("Processing streaming request: %v", req)
-
-	firstName := req.Greeting.FirstName
-	lastName := req.Greeting.LastName
+	firstName := req.GetGreeting().GetFirstName()
+	lastName := req.GetGreeting().GetLastName()
 
 	for i := 0; i < 10; i++ {
 		result := "Hello " + firstName + " " + lastName + ". " + strconv.Itoa(i)
@@ -69,7 +79,9 @@ func (*server) GreetManyTimes(req *greetpb.GreetManyTimesRequest, stream greetpb
 			Result: result,
 		}
 
-		stream.Send(res)
+		if err := stream.Send(res); err != nil {
+			return rpcerr.FromStream(stream.Context(), "sending greeting", err)
+		}
 
 		time.Sleep(time.Second)
 	}
@@ -78,7 +90,6 @@ func (*server) GreetManyTimes(req *greetpb.GreetManyTimesRequest, stream greetpb
 }
 
 func (*server) LongGreet(stream greetpb.GreetService_LongGreetServer) error {
-	log.Info("LongGreet was invoked with a streaming request\n")
 	result := ""
 	for {
 		req, err := stream.Recv()
@@ -88,62 +99,113 @@ func (*server) LongGreet(stream greetpb.GreetService_LongGreetServer) error {
 			})
 		}
 		if err != nil {
-			log.Fatalf("error reading from stream: %v", err)
-			return err
+			return rpcerr.FromStream(stream.Context(), "reading greeting", err)
 		}
 
-		firstName := req.Greeting.FirstName
+		firstName := req.GetGreeting().GetFirstName()
 		result += firstName
 	}
 }
 
 func (*server) GreetEveryone(stream greetpb.GreetService_GreetEveryoneServer) error {
-	log.Infof("Processing streaming request for bi dir streaming")
 	for {
 		req, err := stream.Recv()
 		if err == io.EOF {
 			return nil
 		}
 		if err != nil {
-			log.Fatalf("Error while reading client stream: %v", err)
+			return rpcerr.FromStream(stream.Context(), "reading greeting", err)
 		}
-		firstName := req.Greeting.FirstName
+		firstName := req.GetGreeting().GetFirstName()
 		res := "Hello! " + firstName + "!"
 		err = stream.Send(&greetpb.GreetEveryoneResponse{
 			Result: res,
 		})
 		if err != nil {
-			log.Fatalf("Error while sending request: %v", err)
-			return err
+			return rpcerr.FromStream(stream.Context(), "sending greeting", err)
 		}
 	}
 }
 
 func main() {
+	defaults := config.Defaults()
+	defaults.RedactFields = []string{"greet.Greeting.last_name"}
+	cfg, err := config.Load("greet", defaults, os.Args[1:])
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	log.SetLevel(cfg.Level())
+	logging.SetFormat(cfg.LogFormat)
 	log.Infof("Setting up server...")
-	lis, err := net.Listen("tcp", "0.0.0.0:50051")
+
+	shutdownTracing, err := tracing.Setup(context.Background(), cfg.Tracing, "greet_server")
+	if err != nil {
+		log.Fatalf("error setting up tracing: %v", err)
+	}
+	defer shutdownTracing(context.Background())
+
+	opts, err := cfg.ServerOptions("greet.GreetService")
+	if err != nil {
+		log.Fatalf("error configuring server: %v", err)
+	}
+
+	lis, err := net.Listen("tcp", cfg.ListenAddr)
 	if err != nil {
 		log.Fatalf("error listening: %v", err)
 	}
 
-	tls := false
-	var opts []grpc.ServerOption
-	if tls {
-		certFile := "ssl/server.crt"
-		keyFile := "ssl/server.pem"
-		creds, sslError := credentials.NewServerTLSFromFile(certFile, keyFile)
-		if sslError != nil {
-			log.Fatalf("Fail loading certificates: %v", sslError)
-			return
-		}
-	
-		opts = append(opts, grpc.Creds(creds))
+	healthSrv := health.NewServer()
+	reporter := &readiness.Reporter{
+		Health:   healthSrv,
+		Services: []string{"greet.GreetService"},
+		Checks:   cfg.ReadinessChecks(),
+		Interval: 5 * time.Second,
 	}
-	s := grpc.NewServer(opts...)
+	reporter.NotServing()
+	drainer := &shutdown.Drainer{Timeout: cfg.DrainTimeout, Health: healthSrv}
+	var serverOpts []grpc.ServerOption
+	serverOpts = append(serverOpts, tracing.ServerOptions()...)
+	serverOpts = append(serverOpts, metrics.ServerOptions()...)
+	serverOpts = append(serverOpts, logging.New(cfg.LogOptions()).ServerOptions()...)
+	serverOpts = append(serverOpts, recovery.ServerOptions()...)
+	serverOpts = append(serverOpts, drainer.ServerOptions()...)
+	serverOpts = append(serverOpts, opts...)
+	serverOpts = append(serverOpts, validator.ServerOptions()...)
+	s := grpc.NewServer(serverOpts...)
+	healthpb.RegisterHealthServer(s, healthSrv)
 
 	greetpb.RegisterGreetServiceServer(s, &server{})
 
-	if err := s.Serve(lis); err != nil {
+	metrics.Initialize(s)
+
+	ctx := shutdown.SignalContext()
+
+	if cfg.GatewayAddr != "" {
+		dialOpts, err := cfg.GatewayDialOptions()
+		if err != nil {
+			log.Fatalf("error configuring gateway: %v", err)
+		}
+		go func() {
+			err := gateway.Serve(ctx, cfg.GatewayAddr, cfg.LocalEndpoint(), dialOpts, greetpb.RegisterGreetServiceHandlerFromEndpoint)
+			if err != nil {
+				log.Fatalf("error serving gateway: %v", err)
+			}
+		}()
+	}
+
+	if cfg.MetricsAddr != "" {
+		go func() {
+			if err := metrics.Serve(ctx, cfg.MetricsAddr); err != nil {
+				log.Fatalf("error serving metrics: %v", err)
+			}
+		}()
+	}
+
+	go reporter.Run(ctx)
+
+	if err := drainer.Serve(ctx, s, lis); err != nil {
 		log.Fatalf("error serving: %s", err)
 	}
+	log.Info("Server stopped")
 }
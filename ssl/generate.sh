@@ -0,0 +1,32 @@
+#!/bin/bash
+# Generates a certificate authority, a server certificate for localhost and a
+# client certificate for mutual TLS. Run from the repository root; the servers
+# and clients read the files from ./ssl.
+
+set -e
+cd "$(dirname "$0")"
+
+SERVER_CN=localhost
+CLIENT_CN=${CLIENT_CN:-greet-client}
+
+# Certificate authority
+openssl genrsa -out ca.key 4096
+openssl req -new -x509 -days 365 -key ca.key -out ca.crt -subj "/CN=grpc-course-ca"
+
+# Server certificate, also usable as a client certificate by the REST gateway
+openssl genrsa -out server.key 4096
+openssl req -new -key server.key -out server.csr -subj "/CN=${SERVER_CN}"
+printf "subjectAltName=DNS:${SERVER_CN}\nextendedKeyUsage=serverAuth,clientAuth\n" > server.ext
+openssl x509 -req -days 365 -in server.csr -CA ca.crt -CAkey ca.key -CAcreateserial \
+  -out server.crt -extfile server.ext
+openssl pkcs8 -topk8 -nocrypt -in server.key -out server.pem
+
+# Client certificate, its common name is the identity seen by the servers
+openssl genrsa -out client.key 4096
+openssl req -new -key client.key -out client.csr -subj "/CN=${CLIENT_CN}"
+printf "extendedKeyUsage=clientAuth\n" > client.ext
+openssl x509 -req -days 365 -in client.csr -CA ca.crt -CAkey ca.key -CAcreateserial \
+  -out client.crt -extfile client.ext
+openssl pkcs8 -topk8 -nocrypt -in client.key -out client.pem
+
+rm -f *.csr *.ext *.srl
@@ -0,0 +1,94 @@
+// Command healthcheck queries the gRPC health service of a server and exits
+// with 0 when the service is SERVING and 1 otherwise, so it can be used as a
+// container HEALTHCHECK, e.g.
+//
+//	healthcheck -addr localhost:50051 -service calc.Calculator
+//
+// Against a server requiring client certificates it presents the one of
+// -tls-cert-file and -tls-key-file, e.g. the server certificate, which is
+// valid for client auth.
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func main() {
+	addr := flag.String("addr", "localhost:50051", "address of the server")
+	service := flag.String("service", "", "full name of the service to check, empty for the whole server")
+	timeout := flag.Duration("timeout", 3*time.Second, "timeout of the check")
+	useTLS := flag.Bool("tls", false, "connect over TLS")
+	caFile := flag.String("tls-ca-file", "", "certificate authority to verify the server with")
+	certFile := flag.String("tls-cert-file", "", "client certificate presented to servers requiring one")
+	keyFile := flag.String("tls-key-file", "", "private key of the client certificate")
+	flag.Parse()
+
+	opts := []grpc.DialOption{grpc.WithInsecure()}
+	if *useTLS {
+		cfg, err := tlsConfig(*caFile, *certFile, *keyFile)
+		if err != nil {
+			fail("loading certificates: %v", err)
+		}
+		opts = []grpc.DialOption{grpc.WithTransportCredentials(credentials.NewTLS(cfg))}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+	cc, err := grpc.DialContext(ctx, *addr, append(opts, grpc.WithBlock())...)
+	if err != nil {
+		fail("dialing %s: %v", *addr, err)
+	}
+	defer cc.Close()
+
+	resp, err := healthpb.NewHealthClient(cc).Check(ctx, &healthpb.HealthCheckRequest{Service: *service})
+	if err != nil {
+		fail("checking health: %v", err)
+	}
+	fmt.Println(resp.Status)
+	if resp.Status != healthpb.HealthCheckResponse_SERVING {
+		os.Exit(1)
+	}
+}
+
+// tlsConfig verifies the server against the CA file, or the system roots when
+// it is empty, and presents the client certificate when one is given.
+func tlsConfig(caFile, certFile, keyFile string) (*tls.Config, error) {
+	cfg := &tls.Config{}
+	if caFile != "" {
+		pem, err := ioutil.ReadFile(caFile)
+		if err != nil {
+			return nil, err
+		}
+		cfg.RootCAs = x509.NewCertPool()
+		if !cfg.RootCAs.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in %s", caFile)
+		}
+	}
+	if (certFile == "") != (keyFile == "") {
+		return nil, fmt.Errorf("-tls-cert-file and -tls-key-file must be set together")
+	}
+	if certFile != "" {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, err
+		}
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+	return cfg, nil
+}
+
+func fail(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, format+"\n", args...)
+	os.Exit(1)
+}
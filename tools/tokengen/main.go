@@ -0,0 +1,47 @@
+// Command tokengen issues bearer tokens for the servers from a local key set,
+// e.g.
+//
+//	go run tools/tokengen/main.go -keys auth/keys.yaml -kid 2020-05 \
+//	  -sub billing -scope "calc.use calc.factor"
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"grpc-course/common/auth"
+
+	jwt "github.com/dgrijalva/jwt-go"
+)
+
+func main() {
+	keyFile := flag.String("keys", "auth/keys.yaml", "path of the key set")
+	kid := flag.String("kid", "", "ID of the signing key")
+	subject := flag.String("sub", "", "subject of the token")
+	scope := flag.String("scope", "", "space separated scopes to grant")
+	issuer := flag.String("iss", "", "issuer of the token")
+	audience := flag.String("aud", "", "audience of the token")
+	ttl := flag.Duration("ttl", time.Hour, "validity of the token")
+	flag.Parse()
+
+	keys, err := auth.LoadKeySet(*keyFile)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	token, err := auth.Sign(keys, *kid, auth.Claims{
+		Scope: *scope,
+		StandardClaims: jwt.StandardClaims{
+			Subject:  *subject,
+			Issuer:   *issuer,
+			Audience: *audience,
+		},
+	}, *ttl)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	fmt.Println(token)
+}